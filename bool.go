@@ -0,0 +1,55 @@
+package envreader
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ReadEnvBool reads key as a boolean. In addition to the values accepted by
+// strconv.ParseBool it recognizes yes/no, on/off and enabled/disabled,
+// case-insensitively and ignoring surrounding whitespace. If the variable is
+// unset or empty, defaultValue is returned.
+func ReadEnvBool(key string, defaultValue bool) (bool, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	val, err := parseBoolKeyword(envValue)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to bool: %w", envValue, err)
+	}
+	return val, nil
+}
+
+// ReadEnvBoolStrict reads key as a boolean that must be spelled "true" or
+// "false", in any case. Unlike strconv.ParseBool it rejects the shorthand
+// forms 1, 0, t and f, which some config schemas consider ambiguous. If the
+// variable is unset or empty, defaultValue is returned.
+func ReadEnvBoolStrict(key string, defaultValue bool) (bool, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	switch {
+	case strings.EqualFold(envValue, "true"):
+		return true, nil
+	case strings.EqualFold(envValue, "false"):
+		return false, nil
+	}
+	return defaultValue, fmt.Errorf("failed to convert %q to bool: expected true or false: %w", envValue, strconv.ErrSyntax)
+}
+
+// parseBoolKeyword parses s as a boolean, accepting the extended keywords
+// understood by ReadEnvBool. Unrecognized input yields strconv.ErrSyntax.
+func parseBoolKeyword(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "1", "t", "true", "yes", "on", "enabled":
+		return true, nil
+	case "0", "f", "false", "no", "off", "disabled":
+		return false, nil
+	}
+	return false, strconv.ErrSyntax
+}
@@ -0,0 +1,99 @@
+package envreader
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestReadEnvBool(t *testing.T) {
+	tests := []struct {
+		name         string
+		envValue     string
+		setEnv       bool
+		defaultValue bool
+		expectedVal  bool
+		expectedErr  error
+	}{
+		{name: "Standard_True", envValue: "true", setEnv: true, defaultValue: false, expectedVal: true},
+		{name: "Standard_Zero", envValue: "0", setEnv: true, defaultValue: true, expectedVal: false},
+		{name: "Keyword_ON", envValue: "ON", setEnv: true, defaultValue: false, expectedVal: true},
+		{name: "Keyword_Yes", envValue: "Yes", setEnv: true, defaultValue: false, expectedVal: true},
+		{name: "Keyword_Off", envValue: "off", setEnv: true, defaultValue: true, expectedVal: false},
+		{name: "Keyword_No_Padded", envValue: " no ", setEnv: true, defaultValue: true, expectedVal: false},
+		{name: "Keyword_Enabled", envValue: "Enabled", setEnv: true, defaultValue: false, expectedVal: true},
+		{name: "Keyword_Disabled", envValue: "disabled", setEnv: true, defaultValue: true, expectedVal: false},
+		{name: "EnvNotExists", setEnv: false, defaultValue: true, expectedVal: true},
+		{name: "EnvExists_EmptyValue", envValue: "", setEnv: true, defaultValue: true, expectedVal: true},
+		{
+			name:         "InvalidValue",
+			envValue:     "maybe",
+			setEnv:       true,
+			defaultValue: true,
+			expectedVal:  true, // Returns default on error
+			expectedErr:  strconv.ErrSyntax,
+		},
+		{name: "Unsupported_Y", envValue: "y", setEnv: true, defaultValue: false, expectedVal: false, expectedErr: strconv.ErrSyntax},
+		{name: "Unsupported_N", envValue: "N", setEnv: true, defaultValue: true, expectedVal: true, expectedErr: strconv.ErrSyntax},
+		{name: "Unsupported_Enable", envValue: "enable", setEnv: true, defaultValue: false, expectedVal: false, expectedErr: strconv.ErrSyntax},
+		{name: "Unsupported_Disable", envValue: "disable", setEnv: true, defaultValue: true, expectedVal: true, expectedErr: strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_BOOL_KEYWORD", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_BOOL_KEYWORD")
+			}
+
+			actualVal, actualErr := ReadEnvBool("TEST_BOOL_KEYWORD", tt.defaultValue)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvBool(%q, %v) returned value %v; want %v", tt.envValue, tt.defaultValue, actualVal, tt.expectedVal)
+			}
+			if !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvBool(%q, %v) returned error %v; want %v", tt.envValue, tt.defaultValue, actualErr, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestReadEnvBoolStrict(t *testing.T) {
+	tests := []struct {
+		name         string
+		envValue     string
+		setEnv       bool
+		defaultValue bool
+		expectedVal  bool
+		expectedErr  error
+	}{
+		{name: "True", envValue: "true", setEnv: true, defaultValue: false, expectedVal: true},
+		{name: "TRUE", envValue: "TRUE", setEnv: true, defaultValue: false, expectedVal: true},
+		{name: "False", envValue: "False", setEnv: true, defaultValue: true, expectedVal: false},
+		{name: "EnvNotExists", setEnv: false, defaultValue: true, expectedVal: true},
+		{name: "One_Rejected", envValue: "1", setEnv: true, defaultValue: false, expectedVal: false, expectedErr: strconv.ErrSyntax},
+		{name: "Zero_Rejected", envValue: "0", setEnv: true, defaultValue: true, expectedVal: true, expectedErr: strconv.ErrSyntax},
+		{name: "T_Rejected", envValue: "t", setEnv: true, defaultValue: false, expectedVal: false, expectedErr: strconv.ErrSyntax},
+		{name: "F_Rejected", envValue: "F", setEnv: true, defaultValue: true, expectedVal: true, expectedErr: strconv.ErrSyntax},
+		{name: "Yes_Rejected", envValue: "yes", setEnv: true, defaultValue: false, expectedVal: false, expectedErr: strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_BOOL_STRICT", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_BOOL_STRICT")
+			}
+
+			actualVal, actualErr := ReadEnvBoolStrict("TEST_BOOL_STRICT", tt.defaultValue)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvBoolStrict(%q, %v) returned value %v; want %v", tt.envValue, tt.defaultValue, actualVal, tt.expectedVal)
+			}
+			if !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvBoolStrict(%q, %v) returned error %v; want %v", tt.envValue, tt.defaultValue, actualErr, tt.expectedErr)
+			}
+		})
+	}
+}
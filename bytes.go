@@ -0,0 +1,105 @@
+package envreader
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// byteUnits maps lower-cased size suffixes to their multiplier.
+var byteUnits = map[string]int64{
+	"":    1,
+	"b":   1,
+	"kb":  1000,
+	"mb":  1000 * 1000,
+	"gb":  1000 * 1000 * 1000,
+	"tb":  1000 * 1000 * 1000 * 1000,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+}
+
+// ReadEnvBytes reads key as a byte count such as "512", "10MB" or "512 KiB".
+// SI suffixes (KB, MB, GB, TB) are powers of 1000 and IEC suffixes (KiB, MiB,
+// GiB, TiB) are powers of 1024; suffixes are case-insensitive and may be
+// separated from the integer by a space. If the variable is unset or empty,
+// defaultValue is returned.
+func ReadEnvBytes(key string, defaultValue int64) (int64, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	val, err := parseByteSize(envValue)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to byte size: %w", envValue, err)
+	}
+	return val, nil
+}
+
+// ReadEnvBytesSigned is like ReadEnvBytes but accepts an optional leading '+'
+// or '-' for relative changes such as "+256MB" or "-1GiB". The sign applies to
+// the whole size, after the unit multiplier.
+func ReadEnvBytesSigned(key string, defaultValue int64) (int64, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	val, err := parseSignedByteSize(envValue)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to byte size: %w", envValue, err)
+	}
+	return val, nil
+}
+
+// parseSignedByteSize is like parseByteSize but allows a single leading sign
+// directly before the number.
+func parseSignedByteSize(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+		if s == "" || s[0] < '0' || s[0] > '9' {
+			return 0, fmt.Errorf("missing number: %w", strconv.ErrSyntax)
+		}
+	}
+
+	n, err := parseByteSize(s)
+	if err != nil {
+		return 0, err
+	}
+	if negative {
+		return -n, nil
+	}
+	return n, nil
+}
+
+// parseByteSize parses an unsigned integer followed by an optional unit.
+func parseByteSize(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	end := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	if end == 0 {
+		return 0, fmt.Errorf("missing number: %w", strconv.ErrSyntax)
+	}
+
+	unit := strings.ToLower(strings.TrimSpace(s[end:]))
+	multiplier, ok := byteUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit %q", s[end:])
+	}
+
+	n, err := strconv.ParseInt(s[:end], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if n > math.MaxInt64/multiplier {
+		return 0, strconv.ErrRange
+	}
+	return n * multiplier, nil
+}
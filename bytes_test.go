@@ -0,0 +1,141 @@
+package envreader
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestReadEnvBytes(t *testing.T) {
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       int64
+		expectedErr       error
+		expectedErrString string
+	}{
+		{name: "BareNumber", envValue: "512", setEnv: true, expectedVal: 512},
+		{name: "Bytes", envValue: "64B", setEnv: true, expectedVal: 64},
+		{name: "SI_KB", envValue: "2KB", setEnv: true, expectedVal: 2000},
+		{name: "SI_MB", envValue: "10MB", setEnv: true, expectedVal: 10_000_000},
+		{name: "SI_GB_WithSpace", envValue: "3 GB", setEnv: true, expectedVal: 3_000_000_000},
+		{name: "SI_LowerCase", envValue: "5mb", setEnv: true, expectedVal: 5_000_000},
+		{name: "IEC_KiB", envValue: "512KiB", setEnv: true, expectedVal: 512 * 1024},
+		{name: "IEC_MiB_WithSpace", envValue: "1 MiB", setEnv: true, expectedVal: 1 << 20},
+		{name: "IEC_GiB_MixedCase", envValue: "2gib", setEnv: true, expectedVal: 2 << 30},
+		{name: "IEC_TiB", envValue: "1TiB", setEnv: true, expectedVal: 1 << 40},
+		{name: "EnvNotExists", setEnv: false, expectedVal: 1024},
+		{
+			name:              "UnknownUnit",
+			envValue:          "10XB",
+			setEnv:            true,
+			expectedVal:       1024,
+			expectedErrString: `failed to convert "10XB" to byte size: unknown unit "XB"`,
+		},
+		{
+			name:              "MissingNumber",
+			envValue:          "MB",
+			setEnv:            true,
+			expectedVal:       1024,
+			expectedErr:       strconv.ErrSyntax,
+			expectedErrString: `failed to convert "MB" to byte size: missing number: invalid syntax`,
+		},
+		{
+			name:        "Overflow",
+			envValue:    "9000000000TB",
+			setEnv:      true,
+			expectedVal: 1024,
+			expectedErr: strconv.ErrRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_BYTES", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_BYTES")
+			}
+
+			actualVal, actualErr := ReadEnvBytes("TEST_BYTES", 1024)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvBytes(%q) returned value %d; want %d", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErr == nil && tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvBytes(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+				return
+			}
+			if actualErr == nil {
+				t.Fatalf("ReadEnvBytes(%q) expected an error, but got nil", tt.envValue)
+			}
+			if tt.expectedErr != nil && !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvBytes(%q) returned error %v; want %v", tt.envValue, actualErr, tt.expectedErr)
+			}
+			if tt.expectedErrString != "" && actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvBytes(%q) returned error %q; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvBytesSigned(t *testing.T) {
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       int64
+		expectedErr       error
+		expectedErrString string
+	}{
+		{name: "Positive", envValue: "+256MB", setEnv: true, expectedVal: 256_000_000},
+		{name: "Negative", envValue: "-1GiB", setEnv: true, expectedVal: -(1 << 30)},
+		{name: "Negative_WithSpace", envValue: "-512 KiB", setEnv: true, expectedVal: -512 * 1024},
+		{name: "Unsigned", envValue: "10MB", setEnv: true, expectedVal: 10_000_000},
+		{name: "EnvNotExists", setEnv: false, expectedVal: 7},
+		{
+			name:              "SignAfterNumber",
+			envValue:          "2-MB",
+			setEnv:            true,
+			expectedVal:       7,
+			expectedErrString: `failed to convert "2-MB" to byte size: unknown unit "-MB"`,
+		},
+		{name: "DoubleSign", envValue: "+-5MB", setEnv: true, expectedVal: 7, expectedErr: strconv.ErrSyntax},
+		{name: "SignOnly", envValue: "-", setEnv: true, expectedVal: 7, expectedErr: strconv.ErrSyntax},
+		{name: "SpaceAfterSign", envValue: "- 5MB", setEnv: true, expectedVal: 7, expectedErr: strconv.ErrSyntax},
+		{name: "Overflow", envValue: "-9000000000TB", setEnv: true, expectedVal: 7, expectedErr: strconv.ErrRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_BYTES_SIGNED", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_BYTES_SIGNED")
+			}
+
+			actualVal, actualErr := ReadEnvBytesSigned("TEST_BYTES_SIGNED", 7)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvBytesSigned(%q) returned value %d; want %d", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErr == nil && tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvBytesSigned(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+				return
+			}
+			if actualErr == nil {
+				t.Fatalf("ReadEnvBytesSigned(%q) expected an error, but got nil", tt.envValue)
+			}
+			if tt.expectedErr != nil && !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvBytesSigned(%q) returned error %v; want %v", tt.envValue, actualErr, tt.expectedErr)
+			}
+			if tt.expectedErrString != "" && actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvBytesSigned(%q) returned error %q; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package envreader
+
+import "sync"
+
+// CachedReader memoizes the result of reading each variable so repeated reads
+// skip the environment lookup and conversion. Results, including the default
+// and any error, are cached per key until Invalidate or Reset is called. A
+// CachedReader is safe for concurrent use.
+type CachedReader struct {
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value any
+	err   error
+}
+
+// NewCachedReader returns an empty CachedReader.
+func NewCachedReader() *CachedReader {
+	return &CachedReader{entries: make(map[string]cacheEntry)}
+}
+
+// ReadCached returns the cached result for key if one exists for type T, and
+// otherwise calls ReadEnv and caches its result. Reading the same key as a
+// different type replaces the cached entry.
+func ReadCached[T any](c *CachedReader, key string, defaultValue T) (T, error) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if ok {
+		if val, ok := entry.value.(T); ok {
+			return val, entry.err
+		}
+	}
+
+	val, err := ReadEnv(key, defaultValue)
+
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]cacheEntry)
+	}
+	c.entries[key] = cacheEntry{value: val, err: err}
+	c.mu.Unlock()
+	return val, err
+}
+
+// Invalidate drops the cached result for key so the next read consults the
+// environment again.
+func (c *CachedReader) Invalidate(key string) {
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+}
+
+// Reset drops all cached results.
+func (c *CachedReader) Reset() {
+	c.mu.Lock()
+	clear(c.entries)
+	c.mu.Unlock()
+}
@@ -0,0 +1,93 @@
+package envreader
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestCachedReader(t *testing.T) {
+	t.Run("SecondReadUsesCache", func(t *testing.T) {
+		c := NewCachedReader()
+		t.Setenv("TEST_CACHED", "1")
+		if got, _ := ReadCached(c, "TEST_CACHED", 0); got != 1 {
+			t.Fatalf("first ReadCached = %v; want 1", got)
+		}
+
+		t.Setenv("TEST_CACHED", "2")
+		if got, _ := ReadCached(c, "TEST_CACHED", 0); got != 1 {
+			t.Errorf("second ReadCached = %v; want cached 1", got)
+		}
+	})
+
+	t.Run("InvalidatePicksUpNewValue", func(t *testing.T) {
+		c := NewCachedReader()
+		t.Setenv("TEST_CACHED", "1")
+		ReadCached(c, "TEST_CACHED", 0)
+
+		t.Setenv("TEST_CACHED", "2")
+		c.Invalidate("TEST_CACHED")
+		if got, _ := ReadCached(c, "TEST_CACHED", 0); got != 2 {
+			t.Errorf("ReadCached after Invalidate = %v; want 2", got)
+		}
+	})
+
+	t.Run("ResetPicksUpNewValues", func(t *testing.T) {
+		var c CachedReader // The zero value is usable
+		t.Setenv("TEST_CACHED", "a")
+		t.Setenv("TEST_CACHED_OTHER", "b")
+		ReadCached(&c, "TEST_CACHED", "")
+		ReadCached(&c, "TEST_CACHED_OTHER", "")
+
+		t.Setenv("TEST_CACHED", "c")
+		t.Setenv("TEST_CACHED_OTHER", "d")
+		c.Reset()
+		first, _ := ReadCached(&c, "TEST_CACHED", "")
+		second, _ := ReadCached(&c, "TEST_CACHED_OTHER", "")
+		if first != "c" || second != "d" {
+			t.Errorf("ReadCached after Reset = %q, %q; want %q, %q", first, second, "c", "d")
+		}
+	})
+
+	t.Run("ErrorIsCached", func(t *testing.T) {
+		c := NewCachedReader()
+		t.Setenv("TEST_CACHED", "abc")
+		ReadCached(c, "TEST_CACHED", 5)
+
+		t.Setenv("TEST_CACHED", "6")
+		got, err := ReadCached(c, "TEST_CACHED", 5)
+		if got != 5 || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadCached = %v, %v; want cached 5, strconv.ErrSyntax", got, err)
+		}
+	})
+
+	t.Run("DifferentTypeReparses", func(t *testing.T) {
+		c := NewCachedReader()
+		t.Setenv("TEST_CACHED", "1")
+		ReadCached(c, "TEST_CACHED", 0)
+
+		got, err := ReadCached(c, "TEST_CACHED", false)
+		if err != nil || got != true {
+			t.Errorf("ReadCached[bool] = %v, %v; want true, nil", got, err)
+		}
+	})
+
+	t.Run("ConcurrentReads", func(t *testing.T) {
+		c := NewCachedReader()
+		t.Setenv("TEST_CACHED", "42")
+
+		var wg sync.WaitGroup
+		for i := 0; i < 16; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if got, _ := ReadCached(c, "TEST_CACHED", 0); got != 42 {
+					t.Errorf("ReadCached = %v; want 42", got)
+				}
+				c.Invalidate("TEST_CACHED")
+			}()
+		}
+		wg.Wait()
+	})
+}
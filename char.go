@@ -0,0 +1,36 @@
+package envreader
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// ReadEnvRune reads key as a single character such as a delimiter or quote
+// character. The value must be exactly one valid UTF-8 encoded rune. If the
+// variable is unset or empty, defaultValue is returned.
+func ReadEnvRune(key string, defaultValue rune) (rune, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	r, size := utf8.DecodeRuneInString(envValue)
+	if (r == utf8.RuneError && size == 1) || size != len(envValue) {
+		return defaultValue, fmt.Errorf("expected single character for %q, got %q", key, envValue)
+	}
+	return r, nil
+}
+
+// ReadEnvByte is like ReadEnvRune but requires the value to be exactly one
+// byte, which limits it to ASCII characters.
+func ReadEnvByte(key string, defaultValue byte) (byte, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	if len(envValue) != 1 {
+		return defaultValue, fmt.Errorf("expected single byte for %q, got %q", key, envValue)
+	}
+	return envValue[0], nil
+}
@@ -0,0 +1,70 @@
+package envreader
+
+import (
+	"os"
+	"testing"
+)
+
+func TestReadEnvRune(t *testing.T) {
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       rune
+		expectedErrString string
+	}{
+		{name: "ASCII", envValue: ";", setEnv: true, expectedVal: ';'},
+		{name: "Tab", envValue: "\t", setEnv: true, expectedVal: '\t'},
+		{name: "Multibyte", envValue: "€", setEnv: true, expectedVal: '€'},
+		{name: "ReplacementCharacter", envValue: "\uFFFD", setEnv: true, expectedVal: '\uFFFD'},
+		{name: "EnvNotExists", setEnv: false, expectedVal: ','},
+		{
+			name:              "MultipleCharacters",
+			envValue:          "ab",
+			setEnv:            true,
+			expectedVal:       ',',
+			expectedErrString: `expected single character for "TEST_RUNE", got "ab"`,
+		},
+		{
+			name:              "InvalidUTF8",
+			envValue:          "\xff",
+			setEnv:            true,
+			expectedVal:       ',',
+			expectedErrString: `expected single character for "TEST_RUNE", got "\xff"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_RUNE", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_RUNE")
+			}
+
+			actualVal, actualErr := ReadEnvRune("TEST_RUNE", ',')
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvRune(%q) returned value %q; want %q", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvRune(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+			} else if actualErr == nil || actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvRune(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvByte(t *testing.T) {
+	t.Setenv("TEST_BYTE", "|")
+	if got, err := ReadEnvByte("TEST_BYTE", ','); got != '|' || err != nil {
+		t.Errorf("ReadEnvByte = %q, %v; want '|', nil", got, err)
+	}
+
+	t.Setenv("TEST_BYTE", "€")
+	if got, err := ReadEnvByte("TEST_BYTE", ','); got != ',' || err == nil {
+		t.Errorf("ReadEnvByte = %q, %v; want ',' and an error", got, err)
+	}
+}
@@ -0,0 +1,112 @@
+package envreader
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// LoadDotEnv reads a .env file and sets each variable it defines that is not
+// already present in the environment, so the real environment keeps
+// precedence. Lines have the form KEY=VALUE; blank lines and lines starting
+// with # are ignored, whitespace around keys and values is trimmed, and a value
+// wrapped in matching single or double quotes has the quotes removed.
+//
+// Use LoadDotEnvOverride to let the file take precedence instead.
+func LoadDotEnv(path string) error {
+	return loadDotEnv(path, false)
+}
+
+// LoadDotEnvOverride is like LoadDotEnv but sets every variable in the file,
+// replacing any value already present in the environment. The file therefore
+// takes precedence over the real environment.
+func LoadDotEnvOverride(path string) error {
+	return loadDotEnv(path, true)
+}
+
+// MustLoadDotEnv is like LoadDotEnv but panics if the file cannot be loaded.
+// It is intended for TestMain, init functions and small scripts.
+func MustLoadDotEnv(path string) {
+	if err := LoadDotEnv(path); err != nil {
+		panic(fmt.Sprintf("envreader: failed to load %s: %v", path, err))
+	}
+}
+
+// LoadDotEnvReader is like LoadDotEnv but reads the .env content from r, for
+// example an embedded file or a mounted secret. Errors report the offending
+// line number.
+func LoadDotEnvReader(r io.Reader) error {
+	return readDotEnv(r, "", false)
+}
+
+func loadDotEnv(path string, override bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return readDotEnv(f, path, override)
+}
+
+// readDotEnv sets the variables defined by the .env content in r. name, if
+// not empty, prefixes error messages in place of the word "line".
+func readDotEnv(r io.Reader, name string, override bool) error {
+	lineErr := func(lineNo int, err error) error {
+		if name == "" {
+			return fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		return fmt.Errorf("%s:%d: %w", name, lineNo, err)
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		key, value, ok, err := parseDotEnvLine(scanner.Text())
+		if err != nil {
+			return lineErr(lineNo, err)
+		}
+		if !ok {
+			continue
+		}
+		if _, exists := os.LookupEnv(key); exists && !override {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return lineErr(lineNo, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if name == "" {
+			return err
+		}
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// parseDotEnvLine parses a single .env line. ok is false for blank lines and
+// comments.
+func parseDotEnvLine(line string) (key, value string, ok bool, err error) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", "", false, nil
+	}
+
+	key, value, found := strings.Cut(line, "=")
+	if !found {
+		return "", "", false, fmt.Errorf("malformed line %q: missing '='", line)
+	}
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return "", "", false, fmt.Errorf("malformed line %q: empty key", line)
+	}
+
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	}
+	return key, value, true, nil
+}
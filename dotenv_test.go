@@ -0,0 +1,191 @@
+package envreader
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeDotEnv writes content to a .env file in a temporary directory and
+// returns its path.
+func writeDotEnv(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+	return path
+}
+
+// unsetForTest unsets key for the duration of the test and restores its
+// previous value afterwards.
+func unsetForTest(t *testing.T, key string) {
+	t.Helper()
+	t.Setenv(key, "")
+	os.Unsetenv(key)
+}
+
+func TestLoadDotEnv(t *testing.T) {
+	t.Run("ParsesValues", func(t *testing.T) {
+		for _, key := range []string{"DOTENV_PLAIN", "DOTENV_DOUBLE", "DOTENV_SINGLE", "DOTENV_SPACED", "DOTENV_EMPTY"} {
+			unsetForTest(t, key)
+		}
+		path := writeDotEnv(t, strings.Join([]string{
+			"# a comment",
+			"",
+			"DOTENV_PLAIN=plain",
+			`DOTENV_DOUBLE="hello world"`,
+			"DOTENV_SINGLE='single # not a comment'",
+			"  DOTENV_SPACED  =  spaced  ",
+			"DOTENV_EMPTY=",
+		}, "\n"))
+
+		if err := LoadDotEnv(path); err != nil {
+			t.Fatalf("LoadDotEnv returned unexpected error: %v", err)
+		}
+
+		want := map[string]string{
+			"DOTENV_PLAIN":  "plain",
+			"DOTENV_DOUBLE": "hello world",
+			"DOTENV_SINGLE": "single # not a comment",
+			"DOTENV_SPACED": "spaced",
+			"DOTENV_EMPTY":  "",
+		}
+		for key, wantVal := range want {
+			got, ok := os.LookupEnv(key)
+			if !ok || got != wantVal {
+				t.Errorf("%s = %q (set: %v); want %q", key, got, ok, wantVal)
+			}
+		}
+	})
+
+	t.Run("DoesNotOverwriteExisting", func(t *testing.T) {
+		t.Setenv("DOTENV_EXISTING", "from-env")
+		path := writeDotEnv(t, "DOTENV_EXISTING=from-file\n")
+
+		if err := LoadDotEnv(path); err != nil {
+			t.Fatalf("LoadDotEnv returned unexpected error: %v", err)
+		}
+		if got := os.Getenv("DOTENV_EXISTING"); got != "from-env" {
+			t.Errorf("DOTENV_EXISTING = %q; want %q", got, "from-env")
+		}
+	})
+
+	t.Run("MalformedLine", func(t *testing.T) {
+		path := writeDotEnv(t, "# comment\nDOTENV_OK=1\nNOT_A_PAIR\n")
+
+		err := LoadDotEnv(path)
+		if err == nil {
+			t.Fatal("LoadDotEnv expected an error, but got nil")
+		}
+		want := path + `:3: malformed line "NOT_A_PAIR": missing '='`
+		if err.Error() != want {
+			t.Errorf("LoadDotEnv error = %q; want %q", err, want)
+		}
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		err := LoadDotEnv(filepath.Join(t.TempDir(), "missing.env"))
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("LoadDotEnv error = %v; want fs.ErrNotExist", err)
+		}
+	})
+}
+
+func TestLoadDotEnvOverride(t *testing.T) {
+	path := writeDotEnv(t, "DOTENV_PRESET=from-file\nDOTENV_NEW=new\n")
+
+	t.Run("PlainLoaderKeepsExisting", func(t *testing.T) {
+		t.Setenv("DOTENV_PRESET", "from-env")
+		unsetForTest(t, "DOTENV_NEW")
+
+		if err := LoadDotEnv(path); err != nil {
+			t.Fatalf("LoadDotEnv returned unexpected error: %v", err)
+		}
+		if got := os.Getenv("DOTENV_PRESET"); got != "from-env" {
+			t.Errorf("DOTENV_PRESET = %q; want %q", got, "from-env")
+		}
+	})
+
+	t.Run("OverrideReplacesExisting", func(t *testing.T) {
+		t.Setenv("DOTENV_PRESET", "from-env")
+		unsetForTest(t, "DOTENV_NEW")
+
+		if err := LoadDotEnvOverride(path); err != nil {
+			t.Fatalf("LoadDotEnvOverride returned unexpected error: %v", err)
+		}
+		if got := os.Getenv("DOTENV_PRESET"); got != "from-file" {
+			t.Errorf("DOTENV_PRESET = %q; want %q", got, "from-file")
+		}
+		if got := os.Getenv("DOTENV_NEW"); got != "new" {
+			t.Errorf("DOTENV_NEW = %q; want %q", got, "new")
+		}
+	})
+}
+
+func TestMustLoadDotEnv(t *testing.T) {
+	t.Run("ValidFile", func(t *testing.T) {
+		unsetForTest(t, "DOTENV_MUST")
+		MustLoadDotEnv(writeDotEnv(t, "DOTENV_MUST=loaded\n"))
+		if got := os.Getenv("DOTENV_MUST"); got != "loaded" {
+			t.Errorf("DOTENV_MUST = %q; want %q", got, "loaded")
+		}
+	})
+
+	t.Run("MissingFile_Panics", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "missing.env")
+		defer func() {
+			r := recover()
+			if r == nil {
+				t.Fatal("MustLoadDotEnv did not panic on missing file")
+			}
+			msg := fmt.Sprint(r)
+			if !strings.HasPrefix(msg, "envreader: failed to load "+path+": ") || !strings.Contains(msg, "no such file") {
+				t.Errorf("panic message = %q; want path and underlying error", msg)
+			}
+		}()
+		MustLoadDotEnv(path)
+	})
+}
+
+func TestLoadDotEnvReader(t *testing.T) {
+	t.Run("ParsesValues", func(t *testing.T) {
+		for _, key := range []string{"DOTENV_READER_PLAIN", "DOTENV_READER_QUOTED"} {
+			unsetForTest(t, key)
+		}
+		content := "# from an embedded file\n\nDOTENV_READER_PLAIN=plain\nDOTENV_READER_QUOTED=\"a # b\"\n"
+
+		if err := LoadDotEnvReader(strings.NewReader(content)); err != nil {
+			t.Fatalf("LoadDotEnvReader returned unexpected error: %v", err)
+		}
+		if got := os.Getenv("DOTENV_READER_PLAIN"); got != "plain" {
+			t.Errorf("DOTENV_READER_PLAIN = %q; want %q", got, "plain")
+		}
+		if got := os.Getenv("DOTENV_READER_QUOTED"); got != "a # b" {
+			t.Errorf("DOTENV_READER_QUOTED = %q; want %q", got, "a # b")
+		}
+	})
+
+	t.Run("KeepsExisting", func(t *testing.T) {
+		t.Setenv("DOTENV_READER_EXISTING", "from-env")
+		if err := LoadDotEnvReader(strings.NewReader("DOTENV_READER_EXISTING=from-reader\n")); err != nil {
+			t.Fatalf("LoadDotEnvReader returned unexpected error: %v", err)
+		}
+		if got := os.Getenv("DOTENV_READER_EXISTING"); got != "from-env" {
+			t.Errorf("DOTENV_READER_EXISTING = %q; want %q", got, "from-env")
+		}
+	})
+
+	t.Run("MalformedLine", func(t *testing.T) {
+		unsetForTest(t, "DOTENV_READER_OK")
+		err := LoadDotEnvReader(strings.NewReader("# comment\nDOTENV_READER_OK=1\nNOT_A_PAIR\n"))
+		want := `line 3: malformed line "NOT_A_PAIR": missing '='`
+		if err == nil || err.Error() != want {
+			t.Errorf("LoadDotEnvReader returned error %v; want %q", err, want)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package envreader
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// ReadEnvText reads key and decodes it with the UnmarshalText method of *T,
+// which covers types such as net.IP, time.Time and custom enums. If the
+// variable is unset or empty, defaultValue is returned.
+func ReadEnvText[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](key string, defaultValue T) (T, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	var result T
+	if err := PT(&result).UnmarshalText([]byte(envValue)); err != nil {
+		return defaultValue, fmt.Errorf("failed to unmarshal %q into %T: %w", envValue, result, err)
+	}
+	return result, nil
+}
+
+// ReadEnvJSON reads key and decodes it as JSON into a fresh T, which makes
+// maps, slices and structs usable as config values. If the variable is unset
+// or empty, defaultValue is returned.
+func ReadEnvJSON[T any](key string, defaultValue T) (T, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	var result T
+	if err := json.Unmarshal([]byte(envValue), &result); err != nil {
+		return defaultValue, fmt.Errorf("failed to unmarshal JSON %q into %T: %w", envValue, result, err)
+	}
+	return result, nil
+}
+
+// ReadEnvJSONSlice is like ReadEnvJSON for slices, but reports a clear
+// "expected JSON array" error when the value is valid JSON of another kind,
+// such as an object. If the variable is unset or empty, defaultValue is
+// returned.
+func ReadEnvJSONSlice[T any](key string, defaultValue []T) ([]T, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	var result []T
+	var raw json.RawMessage
+	if err := json.Unmarshal([]byte(envValue), &raw); err != nil {
+		return defaultValue, fmt.Errorf("failed to unmarshal JSON %q into %T: %w", envValue, result, err)
+	}
+	if raw[0] != '[' {
+		return defaultValue, fmt.Errorf("failed to unmarshal JSON %q into %T: expected JSON array", envValue, result)
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return defaultValue, fmt.Errorf("failed to unmarshal JSON %q into %T: %w", envValue, result, err)
+	}
+	return result, nil
+}
+
+// byteDecoders maps the encoding names accepted by ReadEnvBytesEncoded to
+// their decode functions.
+var byteDecoders = map[string]func(string) ([]byte, error){
+	"base64":    base64.StdEncoding.DecodeString,
+	"base64url": base64.URLEncoding.DecodeString,
+	"hex":       hex.DecodeString,
+}
+
+// ReadEnvBytesEncoded reads key as binary data in the named encoding, which
+// must be "base64", "base64url" or "hex". If the variable is unset or empty,
+// defaultValue is returned.
+func ReadEnvBytesEncoded(key string, defaultValue []byte, encoding string) ([]byte, error) {
+	decode, ok := byteDecoders[encoding]
+	if !ok {
+		return defaultValue, fmt.Errorf("unsupported encoding %q for %q", encoding, key)
+	}
+
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	val, err := decode(envValue)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to decode %q as %s: %w", key, encoding, err)
+	}
+	return val, nil
+}
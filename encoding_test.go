@@ -0,0 +1,232 @@
+package envreader
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"reflect"
+	"testing"
+)
+
+var errUnknownColor = errors.New("unknown color")
+
+type color int
+
+const (
+	colorRed color = iota + 1
+	colorGreen
+)
+
+func (c *color) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "red":
+		*c = colorRed
+	case "green":
+		*c = colorGreen
+	default:
+		return errUnknownColor
+	}
+	return nil
+}
+
+func TestReadEnvText(t *testing.T) {
+	t.Run("CustomType_ValidValue", func(t *testing.T) {
+		t.Setenv("TEST_TEXT", "green")
+		got, err := ReadEnvText("TEST_TEXT", colorRed)
+		if err != nil || got != colorGreen {
+			t.Errorf("ReadEnvText(%q, colorRed) = %v, %v; want colorGreen, nil", "TEST_TEXT", got, err)
+		}
+	})
+
+	t.Run("CustomType_InvalidValue", func(t *testing.T) {
+		t.Setenv("TEST_TEXT", "blue")
+		got, err := ReadEnvText("TEST_TEXT", colorRed)
+		if got != colorRed {
+			t.Errorf("ReadEnvText(%q, colorRed) returned value %v; want default colorRed", "TEST_TEXT", got)
+		}
+		if !errors.Is(err, errUnknownColor) {
+			t.Errorf("ReadEnvText(%q, colorRed) returned error %v; want errUnknownColor", "TEST_TEXT", err)
+		}
+		want := `failed to unmarshal "blue" into envreader.color: unknown color`
+		if err != nil && err.Error() != want {
+			t.Errorf("ReadEnvText(%q, colorRed) returned error %q; want %q", "TEST_TEXT", err, want)
+		}
+	})
+
+	t.Run("CustomType_EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_TEXT")
+		got, err := ReadEnvText("TEST_TEXT", colorRed)
+		if err != nil || got != colorRed {
+			t.Errorf("ReadEnvText(%q, colorRed) = %v, %v; want colorRed, nil", "TEST_TEXT", got, err)
+		}
+	})
+
+	t.Run("NetIP", func(t *testing.T) {
+		t.Setenv("TEST_TEXT", "10.0.0.1")
+		got, err := ReadEnvText("TEST_TEXT", net.IP(nil))
+		if err != nil || !got.Equal(net.ParseIP("10.0.0.1")) {
+			t.Errorf("ReadEnvText(%q, nil) = %v, %v; want 10.0.0.1, nil", "TEST_TEXT", got, err)
+		}
+	})
+}
+
+func TestReadEnvJSON(t *testing.T) {
+	type flags struct {
+		A bool `json:"a"`
+		B bool `json:"b"`
+	}
+
+	t.Run("Struct", func(t *testing.T) {
+		t.Setenv("TEST_JSON", `{"a":true,"b":false}`)
+		got, err := ReadEnvJSON("TEST_JSON", flags{B: true})
+		if err != nil || got != (flags{A: true}) {
+			t.Errorf("ReadEnvJSON(%q) = %+v, %v; want {A:true B:false}, nil", "TEST_JSON", got, err)
+		}
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		t.Setenv("TEST_JSON", `{"x":1,"y":2}`)
+		got, err := ReadEnvJSON[map[string]int]("TEST_JSON", nil)
+		want := map[string]int{"x": 1, "y": 2}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadEnvJSON(%q) = %v, %v; want %v, nil", "TEST_JSON", got, err, want)
+		}
+	})
+
+	t.Run("Malformed_ReturnsDefault", func(t *testing.T) {
+		t.Setenv("TEST_JSON", `{"a":`)
+		def := flags{B: true}
+		got, err := ReadEnvJSON("TEST_JSON", def)
+		if got != def {
+			t.Errorf("ReadEnvJSON(%q) returned value %+v; want default %+v", "TEST_JSON", got, def)
+		}
+		var syntaxErr *json.SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("ReadEnvJSON(%q) returned error %v; want *json.SyntaxError", "TEST_JSON", err)
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_JSON")
+		def := map[string]int{"default": 1}
+		got, err := ReadEnvJSON("TEST_JSON", def)
+		if err != nil || !reflect.DeepEqual(got, def) {
+			t.Errorf("ReadEnvJSON(%q) = %v, %v; want %v, nil", "TEST_JSON", got, err, def)
+		}
+	})
+}
+
+func TestReadEnvJSONSlice(t *testing.T) {
+	def := []int{1}
+
+	t.Run("Array", func(t *testing.T) {
+		t.Setenv("TEST_JSON_SLICE", ` [1, 2, 3] `)
+		got, err := ReadEnvJSONSlice("TEST_JSON_SLICE", def)
+		want := []int{1, 2, 3}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadEnvJSONSlice(%q) = %v, %v; want %v, nil", "TEST_JSON_SLICE", got, err, want)
+		}
+	})
+
+	t.Run("Object_ExpectedArray", func(t *testing.T) {
+		t.Setenv("TEST_JSON_SLICE", `{"a":1}`)
+		got, err := ReadEnvJSONSlice("TEST_JSON_SLICE", def)
+		if !reflect.DeepEqual(got, def) {
+			t.Errorf("ReadEnvJSONSlice(%q) returned value %v; want default %v", "TEST_JSON_SLICE", got, def)
+		}
+		want := `failed to unmarshal JSON "{\"a\":1}" into []int: expected JSON array`
+		if err == nil || err.Error() != want {
+			t.Errorf("ReadEnvJSONSlice(%q) returned error %v; want %q", "TEST_JSON_SLICE", err, want)
+		}
+	})
+
+	t.Run("ElementTypeMismatch", func(t *testing.T) {
+		t.Setenv("TEST_JSON_SLICE", `[1, "two"]`)
+		var typeErr *json.UnmarshalTypeError
+		if _, err := ReadEnvJSONSlice("TEST_JSON_SLICE", def); !errors.As(err, &typeErr) {
+			t.Errorf("ReadEnvJSONSlice(%q) returned error %v; want *json.UnmarshalTypeError", "TEST_JSON_SLICE", err)
+		}
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		t.Setenv("TEST_JSON_SLICE", `[1, 2`)
+		got, err := ReadEnvJSONSlice("TEST_JSON_SLICE", def)
+		if !reflect.DeepEqual(got, def) {
+			t.Errorf("ReadEnvJSONSlice(%q) returned value %v; want default %v", "TEST_JSON_SLICE", got, def)
+		}
+		var syntaxErr *json.SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("ReadEnvJSONSlice(%q) returned error %v; want *json.SyntaxError", "TEST_JSON_SLICE", err)
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_JSON_SLICE")
+		if got, err := ReadEnvJSONSlice("TEST_JSON_SLICE", def); err != nil || !reflect.DeepEqual(got, def) {
+			t.Errorf("ReadEnvJSONSlice(%q) = %v, %v; want %v, nil", "TEST_JSON_SLICE", got, err, def)
+		}
+	})
+}
+
+func TestReadEnvBytesEncoded(t *testing.T) {
+	def := []byte("default")
+
+	tests := []struct {
+		name        string
+		envValue    string
+		setEnv      bool
+		encoding    string
+		expectedVal []byte
+		expectedErr any // Pointer to the expected error type for errors.As, nil if no error
+		expectErr   bool
+	}{
+		{name: "Base64", envValue: "c2lnbmluZy1rZXk=", setEnv: true, encoding: "base64", expectedVal: []byte("signing-key")},
+		{name: "Base64URL", envValue: "_-8=", setEnv: true, encoding: "base64url", expectedVal: []byte{0xff, 0xef}},
+		{name: "Hex", envValue: "deadbeef", setEnv: true, encoding: "hex", expectedVal: []byte{0xde, 0xad, 0xbe, 0xef}},
+		{name: "EnvNotExists", setEnv: false, encoding: "hex", expectedVal: def},
+		{
+			name:        "Base64_Malformed",
+			envValue:    "not base64!",
+			setEnv:      true,
+			encoding:    "base64",
+			expectedVal: def,
+			expectedErr: new(base64.CorruptInputError),
+			expectErr:   true,
+		},
+		{
+			name:        "Hex_Malformed",
+			envValue:    "xyz",
+			setEnv:      true,
+			encoding:    "hex",
+			expectedVal: def,
+			expectedErr: new(hex.InvalidByteError),
+			expectErr:   true,
+		},
+		{name: "UnsupportedEncoding", envValue: "abc", setEnv: true, encoding: "base32", expectedVal: def, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_BYTES_ENCODED", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_BYTES_ENCODED")
+			}
+
+			actualVal, actualErr := ReadEnvBytesEncoded("TEST_BYTES_ENCODED", def, tt.encoding)
+			if !bytes.Equal(actualVal, tt.expectedVal) {
+				t.Errorf("ReadEnvBytesEncoded(%q, %q) returned value %x; want %x", tt.envValue, tt.encoding, actualVal, tt.expectedVal)
+			}
+			if (actualErr != nil) != tt.expectErr {
+				t.Errorf("ReadEnvBytesEncoded(%q, %q) returned error %v; want error: %v", tt.envValue, tt.encoding, actualErr, tt.expectErr)
+			}
+			if tt.expectedErr != nil && !errors.As(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvBytesEncoded(%q, %q) returned error %v; want %T", tt.envValue, tt.encoding, actualErr, tt.expectedErr)
+			}
+		})
+	}
+}
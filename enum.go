@@ -0,0 +1,59 @@
+package envreader
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// ReadEnvEnum reads key as a string that must be one of allowed, compared
+// case-sensitively. A value outside the set yields defaultValue and an error.
+// If the variable is unset or empty, defaultValue is returned.
+func ReadEnvEnum(key, defaultValue string, allowed []string) (string, error) {
+	return readEnum(key, defaultValue, allowed, func(a, b string) bool { return a == b })
+}
+
+// ReadEnvEnumFold is like ReadEnvEnum but compares case-insensitively. The
+// matching entry from allowed is returned, so "INFO" with allowed "info"
+// yields "info".
+func ReadEnvEnumFold(key, defaultValue string, allowed []string) (string, error) {
+	return readEnum(key, defaultValue, allowed, strings.EqualFold)
+}
+
+func readEnum(key, defaultValue string, allowed []string, equal func(a, b string) bool) (string, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	for _, candidate := range allowed {
+		if equal(envValue, candidate) {
+			return candidate, nil
+		}
+	}
+	return defaultValue, fmt.Errorf("value %q for %q not in allowed set %v", envValue, key, allowed)
+}
+
+// ReadEnvMapped reads key and returns the entry of table whose name matches the
+// value case-insensitively, for string to constant mappings such as log levels
+// or weekdays. An exact match takes precedence over a case-insensitive one. A
+// value not in table yields defaultValue and an error. If the variable is unset
+// or empty, defaultValue is returned.
+func ReadEnvMapped[T any](key string, defaultValue T, table map[string]T) (T, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	if val, ok := table[envValue]; ok {
+		return val, nil
+	}
+	names := slices.Sorted(maps.Keys(table))
+	for _, name := range names {
+		if strings.EqualFold(envValue, name) {
+			return table[name], nil
+		}
+	}
+	return defaultValue, fmt.Errorf("value %q for %q not in allowed set %v", envValue, key, names)
+}
@@ -0,0 +1,125 @@
+package envreader
+
+import (
+	"os"
+	"testing"
+)
+
+func TestReadEnvEnum(t *testing.T) {
+	allowed := []string{"debug", "info", "warn", "error"}
+
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		fold              bool
+		expectedVal       string
+		expectedErrString string
+	}{
+		{name: "Allowed", envValue: "warn", setEnv: true, expectedVal: "warn"},
+		{
+			name:              "Disallowed",
+			envValue:          "verbose",
+			setEnv:            true,
+			expectedVal:       "info",
+			expectedErrString: `value "verbose" for "TEST_ENUM" not in allowed set [debug info warn error]`,
+		},
+		{
+			name:              "WrongCase_CaseSensitive",
+			envValue:          "DEBUG",
+			setEnv:            true,
+			expectedVal:       "info",
+			expectedErrString: `value "DEBUG" for "TEST_ENUM" not in allowed set [debug info warn error]`,
+		},
+		{name: "EnvNotExists", setEnv: false, expectedVal: "info"},
+		{name: "Fold_WrongCase", envValue: "DEBUG", setEnv: true, fold: true, expectedVal: "debug"},
+		{
+			name:              "Fold_Disallowed",
+			envValue:          "trace",
+			setEnv:            true,
+			fold:              true,
+			expectedVal:       "info",
+			expectedErrString: `value "trace" for "TEST_ENUM" not in allowed set [debug info warn error]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_ENUM", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_ENUM")
+			}
+
+			read := ReadEnvEnum
+			if tt.fold {
+				read = ReadEnvEnumFold
+			}
+			actualVal, actualErr := read("TEST_ENUM", "info", allowed)
+			if actualVal != tt.expectedVal {
+				t.Errorf("returned value %q; want %q", actualVal, tt.expectedVal)
+			}
+			if tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("returned unexpected error: %v", actualErr)
+				}
+			} else if actualErr == nil || actualErr.Error() != tt.expectedErrString {
+				t.Errorf("returned error %v; want %q", actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvMapped(t *testing.T) {
+	severities := map[string]int{"debug": -4, "info": 0, "warn": 4, "error": 8}
+
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       int
+		expectedErrString string
+	}{
+		{name: "Exact", envValue: "warn", setEnv: true, expectedVal: 4},
+		{name: "CaseInsensitive", envValue: "ERROR", setEnv: true, expectedVal: 8},
+		{
+			name:              "Miss",
+			envValue:          "verbose",
+			setEnv:            true,
+			expectedVal:       0,
+			expectedErrString: `value "verbose" for "TEST_MAPPED" not in allowed set [debug error info warn]`,
+		},
+		{name: "EnvExists_EmptyValue", envValue: "", setEnv: true, expectedVal: 0},
+		{name: "EnvNotExists", setEnv: false, expectedVal: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_MAPPED", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_MAPPED")
+			}
+
+			got, err := ReadEnvMapped("TEST_MAPPED", 0, severities)
+			if got != tt.expectedVal {
+				t.Errorf("ReadEnvMapped(%q) returned value %v; want %v", tt.envValue, got, tt.expectedVal)
+			}
+			if tt.expectedErrString == "" {
+				if err != nil {
+					t.Errorf("ReadEnvMapped(%q) returned unexpected error: %v", tt.envValue, err)
+				}
+			} else if err == nil || err.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvMapped(%q) returned error %v; want %q", tt.envValue, err, tt.expectedErrString)
+			}
+		})
+	}
+
+	t.Run("ExactMatchPreferred", func(t *testing.T) {
+		t.Setenv("TEST_MAPPED", "Info")
+		table := map[string]int{"INFO": 1, "Info": 2}
+		if got, err := ReadEnvMapped("TEST_MAPPED", 0, table); got != 2 || err != nil {
+			t.Errorf("ReadEnvMapped(%q) = %v, %v; want 2, nil", "Info", got, err)
+		}
+	})
+}
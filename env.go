@@ -1,47 +1,575 @@
 package envreader
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
+// Hooks invoked by ReadEnv and ReadEnvFrom, for example to log which
+// variables fell back to their defaults. Both are nil by default and do not
+// affect return values. They are read without synchronization, so set them
+// during initialization before any reads happen.
+var (
+	// OnDefault is called with the key when defaultValue is returned because
+	// the variable is unset or empty.
+	OnDefault func(key string)
+
+	// OnError is called with the key and the error when the variable's value
+	// fails to convert.
+	OnError func(key string, err error)
+)
+
+// LookupFunc is used by GetRaw, and therefore by every reader in this package,
+// to look variables up. When nil, the default, the process environment is read
+// with os.LookupEnv. Tests can replace it with a map-backed function to isolate
+// reads from the process environment, and should set it back to nil
+// afterwards.
+var LookupFunc func(key string) (string, bool)
+
+// GetRaw returns the unparsed value of the environment variable key and
+// whether it is present, as reported by LookupFunc. Every reader in this
+// package looks variables up through GetRaw.
+func GetRaw(key string) (value string, present bool) {
+	trackKey(key)
+	if LookupFunc == nil {
+		return os.LookupEnv(key)
+	}
+	return LookupFunc(key)
+}
+
+// ReadEnv reads the environment variable key and converts it to T. If the
+// variable is unset, defaultValue is returned. An empty value also yields
+// defaultValue, except for string targets where it is returned as "". On a
+// conversion failure defaultValue is returned together with the error. The
+// range of int targets depends on the platform; use int64 for portable limits.
 func ReadEnv[T any](key string, defaultValue T) (T, error) {
-	envValue := os.Getenv(key)
+	return ReadEnvFrom(GetRaw, key, defaultValue)
+}
+
+// ReadEnvFrom is like ReadEnv but reads key through lookup instead of
+// os.LookupEnv. This allows values to come from any source, such as a map in
+// tests, without touching the process environment.
+func ReadEnvFrom[T any](lookup func(string) (string, bool), key string, defaultValue T) (T, error) {
+	return readEnv(lookup, key, defaultValue, readOptions{})
+}
+
+// ReadString is a non-generic ReadEnv[string] for hot paths: it behaves
+// identically, including calling OnDefault, but skips the generic conversion
+// and its interface boxing. An empty variable is returned as "".
+func ReadString(key, defaultValue string) string {
+	envValue, ok := GetRaw(key)
+	if !ok {
+		if OnDefault != nil {
+			OnDefault(key)
+		}
+		return defaultValue
+	}
+	return envValue
+}
+
+// ReadStringErr is ReadString with the (value, error) signature of ReadEnv.
+// The error is always nil, since any string value converts.
+func ReadStringErr(key, defaultValue string) (string, error) {
+	return ReadString(key, defaultValue), nil
+}
+
+// ReadEnvFold is like ReadEnv but matches key case-insensitively, so "port"
+// satisfies a read of "PORT". An exact match through GetRaw is preferred;
+// otherwise the first matching variable in os.Environ is used. This scans the
+// whole environment and is therefore slower than ReadEnv. The scan only runs
+// while LookupFunc is nil, since it would otherwise bypass the replacement.
+func ReadEnvFold[T any](key string, defaultValue T) (T, error) {
+	return ReadEnvFrom(lookupFold, key, defaultValue)
+}
+
+// lookupFold looks up key ignoring case.
+func lookupFold(key string) (string, bool) {
+	if value, ok := GetRaw(key); ok {
+		return value, true
+	}
+	if LookupFunc != nil {
+		return "", false
+	}
+	for _, kv := range os.Environ() {
+		name, value, _ := strings.Cut(kv, "=")
+		if strings.EqualFold(name, key) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// ReadEnvSecret is like ReadEnv for variables holding passwords, tokens and
+// other secrets: the raw value is replaced with "***" in any returned error,
+// including the Value field of a ConversionError.
+func ReadEnvSecret[T any](key string, defaultValue T) (T, error) {
+	return readEnv(GetRaw, key, defaultValue, readOptions{secret: true})
+}
+
+// readOptions adjusts how readEnv treats a value.
+type readOptions struct {
+	secret bool // Mask the raw value in errors
+}
+
+// readEnv implements ReadEnvFrom, calling the OnDefault and OnError hooks.
+func readEnv[T any](lookup func(string) (string, bool), key string, defaultValue T, opts readOptions) (T, error) {
+	envValue, ok := lookup(key)
+	if !ok || isEmptyValue[T](envValue) {
+		if OnDefault != nil {
+			OnDefault(key)
+		}
+		return defaultValue, nil
+	}
+
+	val, err := convert(key, envValue, defaultValue)
+	if err != nil {
+		if opts.secret {
+			err = maskConversionError(err)
+		}
+		if OnError != nil {
+			OnError(key, err)
+		}
+	}
+	return val, err
+}
 
+// ReadEnvContext is like ReadEnvFrom for lookups that may block, such as a
+// remote config service. If ctx is done before lookup returns, defaultValue is
+// returned together with ctx.Err().
+func ReadEnvContext[T any](ctx context.Context, lookup func(context.Context, string) (string, bool), key string, defaultValue T) (T, error) {
+	if err := ctx.Err(); err != nil {
+		return defaultValue, err
+	}
+
+	type result struct {
+		value string
+		ok    bool
+	}
+	done := make(chan result, 1)
+	go func() {
+		value, ok := lookup(ctx, key)
+		done <- result{value, ok}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return defaultValue, ctx.Err()
+	case r := <-done:
+		if !r.ok {
+			return defaultValue, nil
+		}
+		return convert(key, r.value, defaultValue)
+	}
+}
+
+// ReadEnvTrimmed is like ReadEnv but strips leading and trailing whitespace
+// from the value before converting it, so " 123 " reads as 123. A value made
+// up only of whitespace is treated as empty.
+func ReadEnvTrimmed[T any](key string, defaultValue T) (T, error) {
+	envValue, ok := GetRaw(key)
+	if !ok {
+		return defaultValue, nil
+	}
+	return convert(key, strings.TrimSpace(envValue), defaultValue)
+}
+
+// ReadEnvNormalized is like ReadEnv but passes the raw value through normalize
+// before converting it, for example to trim and lowercase keywords. A string
+// target receives the normalized value. A nil normalize leaves the value
+// unchanged.
+func ReadEnvNormalized[T any](key string, defaultValue T, normalize func(string) string) (T, error) {
+	envValue, ok := GetRaw(key)
+	if !ok {
+		return defaultValue, nil
+	}
+	if normalize != nil {
+		envValue = normalize(envValue)
+	}
+	return convert(key, envValue, defaultValue)
+}
+
+// defaultNullStrings are the values ReadEnvNullable treats as unset when no
+// others are given.
+var defaultNullStrings = []string{"null", "nil", "none"}
+
+// ReadEnvNullable is like ReadEnv but treats a value matching any of
+// nullStrings case-insensitively as unset, for orchestrators that inject a
+// literal "null" for absent values. Without nullStrings, "null", "nil" and
+// "none" are used.
+func ReadEnvNullable[T any](key string, defaultValue T, nullStrings ...string) (T, error) {
+	if len(nullStrings) == 0 {
+		nullStrings = defaultNullStrings
+	}
+	return ReadEnvFrom(func(key string) (string, bool) {
+		envValue, ok := GetRaw(key)
+		for _, null := range nullStrings {
+			if strings.EqualFold(envValue, null) {
+				return "", false
+			}
+		}
+		return envValue, ok
+	}, key, defaultValue)
+}
+
+// ReadEnvUnquote reads key as a string and removes one level of quoting, for
+// values set through tools that keep the quotes, such as NAME="John Doe". A
+// double-quoted value has its escapes resolved with strconv.Unquote; a
+// single-quoted value is taken literally, as in a shell. Unquoted values are
+// returned unchanged. A value with an opening quote but no matching closing
+// quote fails with strconv.ErrSyntax.
+func ReadEnvUnquote(key string, defaultValue string) (string, error) {
+	envValue, ok := GetRaw(key)
+	if !ok {
+		return defaultValue, nil
+	}
+	if envValue == "" || (envValue[0] != '"' && envValue[0] != '\'') {
+		return envValue, nil
+	}
+
+	if envValue[0] == '\'' {
+		if len(envValue) < 2 || envValue[len(envValue)-1] != '\'' {
+			return defaultValue, &ConversionError{Key: key, Value: envValue, TargetType: "string", Err: strconv.ErrSyntax}
+		}
+		return envValue[1 : len(envValue)-1], nil
+	}
+	val, err := strconv.Unquote(envValue)
+	if err != nil {
+		return defaultValue, &ConversionError{Key: key, Value: envValue, TargetType: "string", Err: err}
+	}
+	return val, nil
+}
+
+// LookupEnvTyped reads key and converts it to T, reporting whether the
+// variable is present so callers can supply their own default logic. If the
+// variable is absent the zero value is returned with present set to false. If
+// it is present but fails to convert, the zero value is returned with present
+// set to true and the error. An empty value converts as it does for ReadEnv.
+func LookupEnvTyped[T any](key string) (value T, present bool, err error) {
+	envValue, present := GetRaw(key)
+	if !present {
+		return value, false, nil
+	}
+	value, err = convert(key, envValue, value)
+	return value, true, err
+}
+
+// ReadEnvStrict is like ReadEnv but treats a variable that is set to an empty
+// value as a misconfiguration: defaultValue is returned together with an
+// error. An unset variable still yields defaultValue without error.
+func ReadEnvStrict[T any](key string, defaultValue T) (T, error) {
+	envValue, ok := GetRaw(key)
+	if !ok {
+		return defaultValue, nil
+	}
 	if envValue == "" {
+		return defaultValue, fmt.Errorf("environment variable %q is set but empty", key)
+	}
+	return convert(key, envValue, defaultValue)
+}
+
+// ReadEnvPtr reads key and converts it to T like ReadEnv, returning a pointer
+// to the result so callers can tell "not provided" from "provided as the zero
+// value". It returns nil if the variable is unset or empty, and nil with the
+// error if the value fails to convert.
+func ReadEnvPtr[T any](key string) (*T, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return nil, nil
+	}
+
+	var zero T
+	val, err := convert(key, envValue, zero)
+	if err != nil {
+		return nil, err
+	}
+	return &val, nil
+}
+
+// ReadEnvZero is like ReadEnv with the zero value of T as the default. An unset
+// variable yields the zero value without error; an invalid one yields the zero
+// value together with the conversion error.
+func ReadEnvZero[T any](key string) (T, error) {
+	var zero T
+	return ReadEnv(key, zero)
+}
+
+// ReadEnvNoFallback is like ReadEnv but returns the zero value of T instead of
+// defaultValue when the value fails to convert, so a misconfiguration is not
+// masked by a plausible default. An unset or empty variable still yields
+// defaultValue.
+func ReadEnvNoFallback[T any](key string, defaultValue T) (T, error) {
+	val, err := ReadEnv(key, defaultValue)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return val, nil
+}
+
+// ReadEnvIndirect reads key as the name of another variable and converts that
+// variable's value like ReadEnv, so DB_PASSWORD_SOURCE=VAULT_DB_PW reads
+// VAULT_DB_PW. If key is unset or empty, defaultValue is returned. If the named
+// variable is unset, defaultValue is returned with an error wrapping
+// ErrMissingRequired that names both variables.
+func ReadEnvIndirect[T any](key string, defaultValue T) (T, error) {
+	target, _ := GetRaw(key)
+	target = strings.TrimSpace(target)
+	if target == "" {
 		return defaultValue, nil
 	}
 
+	envValue, ok := GetRaw(target)
+	if !ok {
+		return defaultValue, fmt.Errorf("%w: %q (named by %q)", ErrMissingRequired, target, key)
+	}
+	return convert(target, envValue, defaultValue)
+}
+
+// ReadEnvAny reads the first of keys that is set to a non-empty value and
+// converts it like ReadEnv. Later keys are not consulted once a value is
+// found, even if it fails to convert. If none of the keys are set,
+// defaultValue is returned.
+func ReadEnvAny[T any](defaultValue T, keys ...string) (T, error) {
+	for _, key := range keys {
+		if envValue, _ := GetRaw(key); envValue != "" {
+			return convert(key, envValue, defaultValue)
+		}
+	}
+	return defaultValue, nil
+}
+
+// ReadEnvLayered is like ReadEnv but falls back to fallbacks[key] when the
+// variable is unset or empty, before resorting to defaultValue. This lets a
+// defaults file loaded into a map sit between the environment and hardcoded
+// defaults. A value taken from fallbacks is converted like any other and may
+// fail with a conversion error.
+func ReadEnvLayered[T any](key string, fallbacks map[string]string, defaultValue T) (T, error) {
+	if envValue, _ := GetRaw(key); envValue != "" {
+		return convert(key, envValue, defaultValue)
+	}
+	if fallbackValue, ok := fallbacks[key]; ok {
+		return convert(key, fallbackValue, defaultValue)
+	}
+	return defaultValue, nil
+}
+
+// ReadEnvWithSource is like ReadEnv but also reports whether the returned
+// value came from the environment. fromEnv is false whenever defaultValue was
+// used, including when the value failed to convert.
+func ReadEnvWithSource[T any](key string, defaultValue T) (value T, fromEnv bool, err error) {
+	envValue, ok := GetRaw(key)
+	if !ok || isEmptyValue[T](envValue) {
+		return defaultValue, false, nil
+	}
+
+	value, err = convert(key, envValue, defaultValue)
+	if err != nil {
+		return value, false, err
+	}
+	return value, true, nil
+}
+
+// ReadEnvValidated is like ReadEnv but passes a value read from the
+// environment to validate. If validate returns an error, defaultValue is
+// returned along with that error annotated with key. validate is not called
+// when defaultValue is used.
+func ReadEnvValidated[T any](key string, defaultValue T, validate func(T) error) (T, error) {
+	val, fromEnv, err := ReadEnvWithSource(key, defaultValue)
+	if err != nil || !fromEnv {
+		return val, err
+	}
+	if err := validate(val); err != nil {
+		return defaultValue, fmt.Errorf("invalid value for %q: %w", key, err)
+	}
+	return val, nil
+}
+
+// Parse converts raw to T with the same rules ReadEnv applies to a variable's
+// value, for reusing them outside the environment such as on flag values. An
+// empty raw yields defaultValue, except for string targets where "" is
+// returned; a failed conversion yields defaultValue and a *ConversionError with
+// an empty Key.
+func Parse[T any](raw string, defaultValue T) (T, error) {
+	return convert("", raw, defaultValue)
+}
+
+// isEmptyValue reports whether envValue should be treated as absent for T.
+// Only string targets accept an empty value.
+func isEmptyValue[T any](envValue string) bool {
+	var zero T
+	_, isString := any(zero).(string)
+	return envValue == "" && !isString
+}
+
+// convert converts envValue, the value of key, to T. An empty envValue yields
+// defaultValue unless T is string, and so does a failed conversion.
+func convert[T any](key, envValue string, defaultValue T) (T, error) {
+	if isEmptyValue[T](envValue) {
+		return defaultValue, nil
+	}
+
+	val, err := parseValue[T](envValue)
+	if err != nil {
+		if convErr, ok := err.(*ConversionError); ok {
+			convErr.Key = key
+		}
+		return defaultValue, err
+	}
+	return val, nil
+}
+
+// parseValue converts raw to T. It only performs the string to T conversion;
+// handling of unset and empty variables is left to the caller. Types without
+// built-in support fall back to parsers registered with RegisterParser.
+// Conversion failures are reported as a *ConversionError without a Key.
+func parseValue[T any](raw string) (T, error) {
 	var result T
 	switch any(result).(type) {
 	case int:
-		val, err := strconv.Atoi(envValue)
+		// int is 32 bits on 32-bit platforms, so a value accepted on amd64 may
+		// fail with strconv.ErrRange on, for example, 32-bit arm.
+		val, err := strconv.ParseInt(raw, 10, strconv.IntSize)
 		if err != nil {
-			return defaultValue, fmt.Errorf("failed to convert %q to int: %w", envValue, err)
+			return result, &ConversionError{Value: raw, TargetType: "int", Err: err}
 		}
-		return any(val).(T), nil
+		return any(int(val)).(T), nil
+	case int8:
+		val, err := strconv.ParseInt(raw, 10, 8)
+		if err != nil {
+			return result, &ConversionError{Value: raw, TargetType: "int8", Err: err}
+		}
+		return any(int8(val)).(T), nil
+	case int16:
+		val, err := strconv.ParseInt(raw, 10, 16)
+		if err != nil {
+			return result, &ConversionError{Value: raw, TargetType: "int16", Err: err}
+		}
+		return any(int16(val)).(T), nil
+	case int32:
+		val, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return result, &ConversionError{Value: raw, TargetType: "int32", Err: err}
+		}
+		return any(int32(val)).(T), nil
 	case int64:
-		val, err := strconv.ParseInt(envValue, 10, 64)
+		val, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return result, &ConversionError{Value: raw, TargetType: "int64", Err: err}
+		}
+		return any(val).(T), nil
+	case complex128:
+		val, err := strconv.ParseComplex(raw, 128)
 		if err != nil {
-			return defaultValue, fmt.Errorf("failed to convert %q to int64: %w", envValue, err)
+			return result, &ConversionError{Value: raw, TargetType: "complex128", Err: err}
+		}
+		return any(val).(T), nil
+	case time.Duration:
+		val, err := time.ParseDuration(raw)
+		if err != nil {
+			return result, &ConversionError{Value: raw, TargetType: "time.Duration", Err: err}
+		}
+		return any(val).(T), nil
+	case uint:
+		val, err := strconv.ParseUint(raw, 10, 0)
+		if err != nil {
+			return result, &ConversionError{Value: raw, TargetType: "uint", Err: err}
+		}
+		return any(uint(val)).(T), nil
+	case uint8:
+		val, err := strconv.ParseUint(raw, 10, 8)
+		if err != nil {
+			return result, &ConversionError{Value: raw, TargetType: "uint8", Err: err}
+		}
+		return any(uint8(val)).(T), nil
+	case uint16:
+		val, err := strconv.ParseUint(raw, 10, 16)
+		if err != nil {
+			return result, &ConversionError{Value: raw, TargetType: "uint16", Err: err}
+		}
+		return any(uint16(val)).(T), nil
+	case uint32:
+		val, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return result, &ConversionError{Value: raw, TargetType: "uint32", Err: err}
+		}
+		return any(uint32(val)).(T), nil
+	case uint64:
+		val, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return result, &ConversionError{Value: raw, TargetType: "uint64", Err: err}
 		}
 		return any(val).(T), nil
 	case string:
-		return any(envValue).(T), nil
+		return any(raw).(T), nil
 	case bool:
-		val, err := strconv.ParseBool(envValue)
+		val, err := strconv.ParseBool(raw)
 		if err != nil {
-			return defaultValue, fmt.Errorf("failed to convert %q to bool: %w", envValue, err)
+			return result, &ConversionError{Value: raw, TargetType: "bool", Err: err}
 		}
 		return any(val).(T), nil
+	case float32:
+		val, err := strconv.ParseFloat(raw, 32)
+		if err != nil {
+			return result, &ConversionError{Value: raw, TargetType: "float32", Err: err}
+		}
+		return any(float32(val)).(T), nil
 	case float64:
-		val, err := strconv.ParseFloat(envValue, 64)
+		val, err := strconv.ParseFloat(raw, 64)
 		if err != nil {
-			return defaultValue, fmt.Errorf("failed to convert %q to float64: %w", envValue, err)
+			return result, &ConversionError{Value: raw, TargetType: "float64", Err: err}
 		}
 		return any(val).(T), nil
 	}
 
-	return defaultValue, fmt.Errorf("unsupported type for environment variable conversion: %T", defaultValue)
+	if val, ok, err := parseRegistered[T](raw); ok {
+		return val, err
+	}
+	return result, fmt.Errorf("unsupported type for environment variable conversion: %T", result)
+}
+
+// MustReadEnv is like ReadEnv but panics if the variable is set and cannot be
+// converted to T. A variable that is simply unset still yields defaultValue.
+func MustReadEnv[T any](key string, defaultValue T) T {
+	val, err := ReadEnv(key, defaultValue)
+	if err != nil {
+		panic(fmt.Sprintf("envreader: invalid value for %q: %v", key, err))
+	}
+	return val
+}
+
+// ReadEnvOr is like ReadEnv but discards the error, returning defaultValue if
+// the variable is unset or cannot be converted.
+func ReadEnvOr[T any](key string, defaultValue T) T {
+	val, _ := ReadEnv(key, defaultValue)
+	return val
+}
+
+// ReadEnvRequired reads key and converts it to T like ReadEnv, but there is no
+// default: an unset or empty variable yields an error wrapping
+// ErrMissingRequired.
+func ReadEnvRequired[T any](key string) (T, error) {
+	var zero T
+	if envValue, _ := GetRaw(key); envValue == "" {
+		return zero, fmt.Errorf("%w: %q", ErrMissingRequired, key)
+	}
+	return ReadEnv(key, zero)
+}
+
+// RequireKeys checks that every key is set to a non-empty value, as a
+// pre-flight check before any values are read. It returns nil if all are
+// present, and otherwise an errors.Join of one ErrMissingRequired error per
+// missing key.
+func RequireKeys(keys ...string) error {
+	var errs []error
+	for _, key := range keys {
+		if envValue, _ := GetRaw(key); envValue == "" {
+			errs = append(errs, fmt.Errorf("%w: %q", ErrMissingRequired, key))
+		}
+	}
+	return errors.Join(errs...)
 }
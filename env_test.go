@@ -1,12 +1,15 @@
 package envreader
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestReadEnv(t *testing.T) {
@@ -22,6 +25,13 @@ func TestReadEnv(t *testing.T) {
 		os.Unsetenv("TEST_INVALID_INT64")
 		os.Unsetenv("TEST_INVALID_BOOL")
 		os.Unsetenv("TEST_INVALID_FLOAT")
+		os.Unsetenv("TEST_UINT")
+		os.Unsetenv("TEST_UINT64")
+		os.Unsetenv("TEST_DURATION")
+		os.Unsetenv("TEST_FLOAT32")
+		os.Unsetenv("TEST_COMPLEX")
+		os.Unsetenv("TEST_NARROW_INT")
+		os.Unsetenv("TEST_NARROW_UINT")
 	})
 
 	tests := []struct {
@@ -68,9 +78,9 @@ func TestReadEnv(t *testing.T) {
 			envValue:          "abc",
 			setEnv:            true,
 			defaultValue:      10,
-			expectedVal:       10,                                                                            // Returns default on conversion error
-			expectedErr:       strconv.ErrSyntax,                                                             // Expect the sentinel error
-			expectedErrString: `failed to convert "abc" to int: strconv.Atoi: parsing "abc": invalid syntax`, // Exact string match for clarity/debugging
+			expectedVal:       10,                                                                                // Returns default on conversion error
+			expectedErr:       strconv.ErrSyntax,                                                                 // Expect the sentinel error
+			expectedErrString: `failed to convert "abc" to int: strconv.ParseInt: parsing "abc": invalid syntax`, // Exact string match for clarity/debugging
 		},
 		{
 			name:         "Int_NegativeValue",
@@ -81,6 +91,74 @@ func TestReadEnv(t *testing.T) {
 			expectedVal:  -50,
 			expectedErr:  nil,
 		},
+		// --- int8/int16/int32 tests ---
+		{
+			name:              "Int8_Overflow",
+			envKey:            "TEST_NARROW_INT",
+			envValue:          "200",
+			setEnv:            true,
+			defaultValue:      int8(1),
+			expectedVal:       int8(1),          // Returns default on conversion error
+			expectedErr:       strconv.ErrRange, // Expect sentinel error
+			expectedErrString: `failed to convert "200" to int8: strconv.ParseInt: parsing "200": value out of range`,
+		},
+		{
+			name:         "Int8_NegativeValue",
+			envKey:       "TEST_NARROW_INT",
+			envValue:     "-128",
+			setEnv:       true,
+			defaultValue: int8(0),
+			expectedVal:  int8(-128),
+			expectedErr:  nil,
+		},
+		{
+			name:         "Int16_EnvExists_ValidValue",
+			envKey:       "TEST_NARROW_INT",
+			envValue:     "200",
+			setEnv:       true,
+			defaultValue: int16(0),
+			expectedVal:  int16(200),
+			expectedErr:  nil,
+		},
+		{
+			name:              "Int16_Overflow",
+			envKey:            "TEST_NARROW_INT",
+			envValue:          "40000",
+			setEnv:            true,
+			defaultValue:      int16(1),
+			expectedVal:       int16(1),         // Returns default on conversion error
+			expectedErr:       strconv.ErrRange, // Expect sentinel error
+			expectedErrString: `failed to convert "40000" to int16: strconv.ParseInt: parsing "40000": value out of range`,
+		},
+		{
+			name:         "Int32_EnvExists_ValidValue",
+			envKey:       "TEST_NARROW_INT",
+			envValue:     "2147483647", // Max int32
+			setEnv:       true,
+			defaultValue: int32(0),
+			expectedVal:  int32(2147483647),
+			expectedErr:  nil,
+		},
+		{
+			name:              "Int32_Overflow",
+			envKey:            "TEST_NARROW_INT",
+			envValue:          "2147483648", // Max int32 + 1
+			setEnv:            true,
+			defaultValue:      int32(1),
+			expectedVal:       int32(1),         // Returns default on conversion error
+			expectedErr:       strconv.ErrRange, // Expect sentinel error
+			expectedErrString: `failed to convert "2147483648" to int32: strconv.ParseInt: parsing "2147483648": value out of range`,
+		},
+		{
+			name:              "Int32_EnvExists_InvalidValue",
+			envKey:            "TEST_NARROW_INT",
+			envValue:          "abc",
+			setEnv:            true,
+			defaultValue:      int32(1),
+			expectedVal:       int32(1),          // Returns default on conversion error
+			expectedErr:       strconv.ErrSyntax, // Expect sentinel error
+			expectedErrString: `failed to convert "abc" to int32: strconv.ParseInt: parsing "abc": invalid syntax`,
+		},
 		// --- int64 tests ---
 		{
 			name:         "Int64_EnvExists_ValidValue",
@@ -127,6 +205,170 @@ func TestReadEnv(t *testing.T) {
 			expectedVal:  int64(9223372036854775807),
 			expectedErr:  nil,
 		},
+		// --- time.Duration tests ---
+		{
+			name:         "Duration_EnvExists_ValidValue",
+			envKey:       "TEST_DURATION",
+			envValue:     "1h30m",
+			setEnv:       true,
+			defaultValue: time.Duration(0),
+			expectedVal:  90 * time.Minute,
+			expectedErr:  nil,
+		},
+		{
+			name:         "Duration_EnvNotExists",
+			envKey:       "NON_EXISTENT_DURATION",
+			setEnv:       false,
+			defaultValue: 30 * time.Second,
+			expectedVal:  30 * time.Second,
+			expectedErr:  nil,
+		},
+		{
+			name:              "Duration_EnvExists_InvalidValue",
+			envKey:            "TEST_DURATION",
+			envValue:          "soon",
+			setEnv:            true,
+			defaultValue:      5 * time.Second,
+			expectedVal:       5 * time.Second, // Returns default on conversion error
+			expectedErr:       errors.New(`failed to convert "soon" to time.Duration: time: invalid duration "soon"`),
+			expectedErrString: `failed to convert "soon" to time.Duration: time: invalid duration "soon"`,
+		},
+		{
+			name:              "Duration_BareNumber",
+			envKey:            "TEST_DURATION",
+			envValue:          "1000",
+			setEnv:            true,
+			defaultValue:      5 * time.Second,
+			expectedVal:       5 * time.Second, // ParseDuration requires a unit
+			expectedErr:       errors.New(`failed to convert "1000" to time.Duration: time: missing unit in duration "1000"`),
+			expectedErrString: `failed to convert "1000" to time.Duration: time: missing unit in duration "1000"`,
+		},
+		// --- uint tests ---
+		{
+			name:         "Uint_EnvExists_ValidValue",
+			envKey:       "TEST_UINT",
+			envValue:     "8080",
+			setEnv:       true,
+			defaultValue: uint(0),
+			expectedVal:  uint(8080),
+			expectedErr:  nil,
+		},
+		{
+			name:         "Uint_EnvNotExists",
+			envKey:       "NON_EXISTENT_UINT",
+			setEnv:       false,
+			defaultValue: uint(10),
+			expectedVal:  uint(10),
+			expectedErr:  nil,
+		},
+		{
+			name:              "Uint_NegativeValue",
+			envKey:            "TEST_UINT",
+			envValue:          "-5",
+			setEnv:            true,
+			defaultValue:      uint(3),
+			expectedVal:       uint(3),           // Returns default on conversion error
+			expectedErr:       strconv.ErrSyntax, // Negative input is a syntax error for ParseUint
+			expectedErrString: `failed to convert "-5" to uint: strconv.ParseUint: parsing "-5": invalid syntax`,
+		},
+		// --- uint8/uint16/uint32 tests ---
+		{
+			name:         "Uint8_MaxValue",
+			envKey:       "TEST_NARROW_UINT",
+			envValue:     "255",
+			setEnv:       true,
+			defaultValue: uint8(0),
+			expectedVal:  uint8(255),
+			expectedErr:  nil,
+		},
+		{
+			name:              "Uint8_Overflow",
+			envKey:            "TEST_NARROW_UINT",
+			envValue:          "256",
+			setEnv:            true,
+			defaultValue:      uint8(1),
+			expectedVal:       uint8(1),         // Returns default on conversion error
+			expectedErr:       strconv.ErrRange, // Expect sentinel error
+			expectedErrString: `failed to convert "256" to uint8: strconv.ParseUint: parsing "256": value out of range`,
+		},
+		{
+			name:              "Uint8_NegativeValue",
+			envKey:            "TEST_NARROW_UINT",
+			envValue:          "-1",
+			setEnv:            true,
+			defaultValue:      uint8(1),
+			expectedVal:       uint8(1),          // Returns default on conversion error
+			expectedErr:       strconv.ErrSyntax, // Expect sentinel error
+			expectedErrString: `failed to convert "-1" to uint8: strconv.ParseUint: parsing "-1": invalid syntax`,
+		},
+		{
+			name:         "Uint16_EnvExists_ValidValue",
+			envKey:       "TEST_NARROW_UINT",
+			envValue:     "65535",
+			setEnv:       true,
+			defaultValue: uint16(0),
+			expectedVal:  uint16(65535),
+			expectedErr:  nil,
+		},
+		{
+			name:              "Uint16_Overflow",
+			envKey:            "TEST_NARROW_UINT",
+			envValue:          "65536",
+			setEnv:            true,
+			defaultValue:      uint16(1),
+			expectedVal:       uint16(1),        // Returns default on conversion error
+			expectedErr:       strconv.ErrRange, // Expect sentinel error
+			expectedErrString: `failed to convert "65536" to uint16: strconv.ParseUint: parsing "65536": value out of range`,
+		},
+		{
+			name:         "Uint32_EnvExists_ValidValue",
+			envKey:       "TEST_NARROW_UINT",
+			envValue:     "4294967295", // Max uint32
+			setEnv:       true,
+			defaultValue: uint32(0),
+			expectedVal:  uint32(4294967295),
+			expectedErr:  nil,
+		},
+		{
+			name:              "Uint32_Overflow",
+			envKey:            "TEST_NARROW_UINT",
+			envValue:          "4294967296", // Max uint32 + 1
+			setEnv:            true,
+			defaultValue:      uint32(1),
+			expectedVal:       uint32(1),        // Returns default on conversion error
+			expectedErr:       strconv.ErrRange, // Expect sentinel error
+			expectedErrString: `failed to convert "4294967296" to uint32: strconv.ParseUint: parsing "4294967296": value out of range`,
+		},
+		// --- uint64 tests ---
+		{
+			name:         "Uint64_EnvExists_ValidValue",
+			envKey:       "TEST_UINT64",
+			envValue:     "18446744073709551615", // Max uint64
+			setEnv:       true,
+			defaultValue: uint64(0),
+			expectedVal:  uint64(18446744073709551615),
+			expectedErr:  nil,
+		},
+		{
+			name:              "Uint64_Overflow",
+			envKey:            "TEST_UINT64",
+			envValue:          "18446744073709551616", // Max uint64 + 1
+			setEnv:            true,
+			defaultValue:      uint64(1),
+			expectedVal:       uint64(1),        // Returns default on conversion error
+			expectedErr:       strconv.ErrRange, // Expect sentinel error
+			expectedErrString: `failed to convert "18446744073709551616" to uint64: strconv.ParseUint: parsing "18446744073709551616": value out of range`,
+		},
+		{
+			name:              "Uint64_NegativeValue",
+			envKey:            "TEST_UINT64",
+			envValue:          "-5",
+			setEnv:            true,
+			defaultValue:      uint64(2),
+			expectedVal:       uint64(2),         // Returns default on conversion error
+			expectedErr:       strconv.ErrSyntax, // Expect sentinel error
+			expectedErrString: `failed to convert "-5" to uint64: strconv.ParseUint: parsing "-5": invalid syntax`,
+		},
 		// --- String tests ---
 		{
 			name:         "String_EnvExists_ValidValue",
@@ -151,7 +393,7 @@ func TestReadEnv(t *testing.T) {
 			envValue:     "",
 			setEnv:       true,
 			defaultValue: "empty_default",
-			expectedVal:  "empty_default",
+			expectedVal:  "", // An explicitly empty string is returned as-is
 			expectedErr:  nil,
 		},
 
@@ -202,6 +444,54 @@ func TestReadEnv(t *testing.T) {
 			expectedErrString: `failed to convert "not_a_bool" to bool: strconv.ParseBool: parsing "not_a_bool": invalid syntax`,
 		},
 
+		// --- Float32 tests ---
+		{
+			name:         "Float32_EnvExists_ValidValue",
+			envKey:       "TEST_FLOAT32",
+			envValue:     "3.14",
+			setEnv:       true,
+			defaultValue: float32(0),
+			expectedVal:  float32(3.14),
+			expectedErr:  nil,
+		},
+		{
+			name:         "Float32_EnvNotExists",
+			envKey:       "NON_EXISTENT_FLOAT32",
+			setEnv:       false,
+			defaultValue: float32(1.23),
+			expectedVal:  float32(1.23),
+			expectedErr:  nil,
+		},
+		{
+			name:         "Float32_EnvExists_EmptyValue",
+			envKey:       "TEST_EMPTY",
+			envValue:     "",
+			setEnv:       true,
+			defaultValue: float32(9.99),
+			expectedVal:  float32(9.99),
+			expectedErr:  nil,
+		},
+		{
+			name:              "Float32_EnvExists_InvalidValue",
+			envKey:            "TEST_FLOAT32",
+			envValue:          "not_a_float",
+			setEnv:            true,
+			defaultValue:      float32(5.5),
+			expectedVal:       float32(5.5),      // Returns default on error
+			expectedErr:       strconv.ErrSyntax, // Expect sentinel error
+			expectedErrString: `failed to convert "not_a_float" to float32: strconv.ParseFloat: parsing "not_a_float": invalid syntax`,
+		},
+		{
+			name:              "Float32_Overflow",
+			envKey:            "TEST_FLOAT32",
+			envValue:          "1e39", // Beyond math.MaxFloat32
+			setEnv:            true,
+			defaultValue:      float32(5.5),
+			expectedVal:       float32(5.5),     // Returns default on error
+			expectedErr:       strconv.ErrRange, // Expect sentinel error
+			expectedErrString: `failed to convert "1e39" to float32: strconv.ParseFloat: parsing "1e39": value out of range`,
+		},
+
 		// --- Float64 tests ---
 		{
 			name:         "Float64_EnvExists_ValidValue",
@@ -240,6 +530,36 @@ func TestReadEnv(t *testing.T) {
 			expectedErrString: `failed to convert "not_a_float" to float64: strconv.ParseFloat: parsing "not_a_float": invalid syntax`,
 		},
 
+		// --- complex128 tests ---
+		{
+			name:         "Complex128_EnvExists_ValidValue",
+			envKey:       "TEST_COMPLEX",
+			envValue:     "(3+4i)",
+			setEnv:       true,
+			defaultValue: complex128(0),
+			expectedVal:  complex(3, 4),
+			expectedErr:  nil,
+		},
+		{
+			name:         "Complex128_RealOnly",
+			envKey:       "TEST_COMPLEX",
+			envValue:     "2.5",
+			setEnv:       true,
+			defaultValue: complex128(0),
+			expectedVal:  complex(2.5, 0),
+			expectedErr:  nil,
+		},
+		{
+			name:              "Complex128_EnvExists_InvalidValue",
+			envKey:            "TEST_COMPLEX",
+			envValue:          "3+4j",
+			setEnv:            true,
+			defaultValue:      complex(1, 1),
+			expectedVal:       complex(1, 1),     // Returns default on error
+			expectedErr:       strconv.ErrSyntax, // Expect sentinel error
+			expectedErrString: `failed to convert "3+4j" to complex128: strconv.ParseComplex: parsing "3+4j": invalid syntax`,
+		},
+
 		// --- Unsupported type test ---
 		{
 			name:              "UnsupportedType_Struct",
@@ -280,12 +600,34 @@ func TestReadEnv(t *testing.T) {
 			switch def := tt.defaultValue.(type) {
 			case int:
 				actualVal, actualErr = ReadEnv[int](tt.envKey, def)
+			case int8:
+				actualVal, actualErr = ReadEnv[int8](tt.envKey, def)
+			case int16:
+				actualVal, actualErr = ReadEnv[int16](tt.envKey, def)
+			case int32:
+				actualVal, actualErr = ReadEnv[int32](tt.envKey, def)
 			case int64:
 				actualVal, actualErr = ReadEnv[int64](tt.envKey, def)
+			case complex128:
+				actualVal, actualErr = ReadEnv[complex128](tt.envKey, def)
+			case time.Duration:
+				actualVal, actualErr = ReadEnv[time.Duration](tt.envKey, def)
+			case uint:
+				actualVal, actualErr = ReadEnv[uint](tt.envKey, def)
+			case uint8:
+				actualVal, actualErr = ReadEnv[uint8](tt.envKey, def)
+			case uint16:
+				actualVal, actualErr = ReadEnv[uint16](tt.envKey, def)
+			case uint32:
+				actualVal, actualErr = ReadEnv[uint32](tt.envKey, def)
+			case uint64:
+				actualVal, actualErr = ReadEnv[uint64](tt.envKey, def)
 			case string:
 				actualVal, actualErr = ReadEnv[string](tt.envKey, def)
 			case bool:
 				actualVal, actualErr = ReadEnv[bool](tt.envKey, def)
+			case float32:
+				actualVal, actualErr = ReadEnv[float32](tt.envKey, def)
 			case float64:
 				actualVal, actualErr = ReadEnv[float64](tt.envKey, def)
 			case struct{ Name string }: // Specific case for our test struct
@@ -331,3 +673,1303 @@ func TestReadEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestMustReadEnv(t *testing.T) {
+	t.Run("EnvNotExists_ReturnsDefault", func(t *testing.T) {
+		os.Unsetenv("TEST_MUST_INT")
+		if got := MustReadEnv("TEST_MUST_INT", 42); got != 42 {
+			t.Errorf("MustReadEnv(%q, 42) = %v; want 42", "TEST_MUST_INT", got)
+		}
+	})
+
+	t.Run("EnvExists_ValidValue", func(t *testing.T) {
+		t.Setenv("TEST_MUST_INT", "7")
+		if got := MustReadEnv("TEST_MUST_INT", 42); got != 7 {
+			t.Errorf("MustReadEnv(%q, 42) = %v; want 7", "TEST_MUST_INT", got)
+		}
+	})
+
+	t.Run("EnvExists_InvalidValue_Panics", func(t *testing.T) {
+		t.Setenv("TEST_MUST_INT", "abc")
+		defer func() {
+			r := recover()
+			if r == nil {
+				t.Fatal("MustReadEnv did not panic on invalid value")
+			}
+			msg := fmt.Sprint(r)
+			want := `envreader: invalid value for "TEST_MUST_INT": failed to convert "abc" to int: strconv.ParseInt: parsing "abc": invalid syntax`
+			if msg != want {
+				t.Errorf("panic message = %q; want %q", msg, want)
+			}
+		}()
+		MustReadEnv("TEST_MUST_INT", 42)
+	})
+}
+
+func TestReadEnvOr(t *testing.T) {
+	t.Run("ValidValue", func(t *testing.T) {
+		t.Setenv("TEST_OR", "2.5")
+		if got := ReadEnvOr("TEST_OR", 1.0); got != 2.5 {
+			t.Errorf("ReadEnvOr(%q, 1.0) = %v; want 2.5", "TEST_OR", got)
+		}
+	})
+
+	t.Run("InvalidValue_ReturnsDefault", func(t *testing.T) {
+		t.Setenv("TEST_OR", "abc")
+		if got := ReadEnvOr("TEST_OR", 1.0); got != 1.0 {
+			t.Errorf("ReadEnvOr(%q, 1.0) = %v; want 1.0", "TEST_OR", got)
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_OR")
+		if got := ReadEnvOr("TEST_OR", "fallback"); got != "fallback" {
+			t.Errorf("ReadEnvOr(%q, %q) = %q; want %q", "TEST_OR", "fallback", got, "fallback")
+		}
+	})
+}
+
+func TestReadEnvRequired(t *testing.T) {
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_REQUIRED")
+		_, err := ReadEnvRequired[string]("TEST_REQUIRED")
+		if !errors.Is(err, ErrMissingRequired) {
+			t.Fatalf("ReadEnvRequired(%q) error = %v; want ErrMissingRequired", "TEST_REQUIRED", err)
+		}
+		want := `required environment variable is not set: "TEST_REQUIRED"`
+		if err.Error() != want {
+			t.Errorf("ReadEnvRequired(%q) error = %q; want %q", "TEST_REQUIRED", err, want)
+		}
+	})
+
+	t.Run("EnvExists_EmptyValue", func(t *testing.T) {
+		t.Setenv("TEST_REQUIRED", "")
+		if _, err := ReadEnvRequired[int]("TEST_REQUIRED"); !errors.Is(err, ErrMissingRequired) {
+			t.Errorf("ReadEnvRequired(%q) error = %v; want ErrMissingRequired", "TEST_REQUIRED", err)
+		}
+	})
+
+	t.Run("EnvExists_ValidValue", func(t *testing.T) {
+		t.Setenv("TEST_REQUIRED", "5432")
+		got, err := ReadEnvRequired[int]("TEST_REQUIRED")
+		if err != nil {
+			t.Fatalf("ReadEnvRequired(%q) returned unexpected error: %v", "TEST_REQUIRED", err)
+		}
+		if got != 5432 {
+			t.Errorf("ReadEnvRequired(%q) = %v; want 5432", "TEST_REQUIRED", got)
+		}
+	})
+
+	t.Run("EnvExists_InvalidValue", func(t *testing.T) {
+		t.Setenv("TEST_REQUIRED", "abc")
+		_, err := ReadEnvRequired[int]("TEST_REQUIRED")
+		if !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvRequired(%q) error = %v; want strconv.ErrSyntax", "TEST_REQUIRED", err)
+		}
+	})
+}
+
+func TestRequireKeys(t *testing.T) {
+	t.Run("AllPresent", func(t *testing.T) {
+		t.Setenv("TEST_REQUIRE_A", "a")
+		t.Setenv("TEST_REQUIRE_B", "b")
+		if err := RequireKeys("TEST_REQUIRE_A", "TEST_REQUIRE_B"); err != nil {
+			t.Errorf("RequireKeys returned error %v; want nil", err)
+		}
+	})
+
+	t.Run("SomeMissing", func(t *testing.T) {
+		t.Setenv("TEST_REQUIRE_A", "a")
+		os.Unsetenv("TEST_REQUIRE_B")
+		os.Unsetenv("TEST_REQUIRE_C")
+		err := RequireKeys("TEST_REQUIRE_A", "TEST_REQUIRE_B", "TEST_REQUIRE_C")
+		if !errors.Is(err, ErrMissingRequired) {
+			t.Fatalf("RequireKeys returned error %v; want ErrMissingRequired", err)
+		}
+		want := `required environment variable is not set: "TEST_REQUIRE_B"` + "\n" +
+			`required environment variable is not set: "TEST_REQUIRE_C"`
+		if err.Error() != want {
+			t.Errorf("RequireKeys returned error %q; want %q", err, want)
+		}
+	})
+
+	t.Run("EmptyValueCountsAsMissing", func(t *testing.T) {
+		t.Setenv("TEST_REQUIRE_A", "")
+		err := RequireKeys("TEST_REQUIRE_A")
+		if !errors.Is(err, ErrMissingRequired) || !strings.Contains(err.Error(), "TEST_REQUIRE_A") {
+			t.Errorf("RequireKeys returned error %v; want ErrMissingRequired naming %q", err, "TEST_REQUIRE_A")
+		}
+	})
+
+	t.Run("NoKeys", func(t *testing.T) {
+		if err := RequireKeys(); err != nil {
+			t.Errorf("RequireKeys() returned error %v; want nil", err)
+		}
+	})
+}
+
+func TestReadEnvTrimmed(t *testing.T) {
+	t.Run("Int_Padded", func(t *testing.T) {
+		t.Setenv("TEST_TRIMMED", " 123 ")
+		got, err := ReadEnvTrimmed("TEST_TRIMMED", 0)
+		if err != nil || got != 123 {
+			t.Errorf("ReadEnvTrimmed[int](%q) = %v, %v; want 123, nil", "TEST_TRIMMED", got, err)
+		}
+	})
+
+	t.Run("Bool_Padded", func(t *testing.T) {
+		t.Setenv("TEST_TRIMMED", "\ttrue\n")
+		got, err := ReadEnvTrimmed("TEST_TRIMMED", false)
+		if err != nil || got != true {
+			t.Errorf("ReadEnvTrimmed[bool](%q) = %v, %v; want true, nil", "TEST_TRIMMED", got, err)
+		}
+	})
+
+	t.Run("Float64_Padded", func(t *testing.T) {
+		t.Setenv("TEST_TRIMMED", "  2.5")
+		got, err := ReadEnvTrimmed("TEST_TRIMMED", 0.0)
+		if err != nil || got != 2.5 {
+			t.Errorf("ReadEnvTrimmed[float64](%q) = %v, %v; want 2.5, nil", "TEST_TRIMMED", got, err)
+		}
+	})
+
+	t.Run("String_Padded", func(t *testing.T) {
+		t.Setenv("TEST_TRIMMED", "  hello ")
+		got, err := ReadEnvTrimmed("TEST_TRIMMED", "default")
+		if err != nil || got != "hello" {
+			t.Errorf("ReadEnvTrimmed[string](%q) = %q, %v; want %q, nil", "TEST_TRIMMED", got, err, "hello")
+		}
+	})
+
+	t.Run("Int_AllWhitespace_ReturnsDefault", func(t *testing.T) {
+		t.Setenv("TEST_TRIMMED", "   ")
+		got, err := ReadEnvTrimmed("TEST_TRIMMED", 456)
+		if err != nil || got != 456 {
+			t.Errorf("ReadEnvTrimmed[int](%q) = %v, %v; want 456, nil", "TEST_TRIMMED", got, err)
+		}
+	})
+
+	t.Run("Untrimmed_ReadEnvStillFails", func(t *testing.T) {
+		t.Setenv("TEST_TRIMMED", " 123 ")
+		if _, err := ReadEnv("TEST_TRIMMED", 0); !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnv[int](%q) error = %v; want strconv.ErrSyntax", "TEST_TRIMMED", err)
+		}
+	})
+}
+
+func TestReadEnvLayered(t *testing.T) {
+	fallbacks := map[string]string{"TEST_LAYERED": "8080", "TEST_LAYERED_BAD": "eighty"}
+
+	t.Run("EnvWins", func(t *testing.T) {
+		t.Setenv("TEST_LAYERED", "9090")
+		if got, err := ReadEnvLayered("TEST_LAYERED", fallbacks, 80); got != 9090 || err != nil {
+			t.Errorf("ReadEnvLayered(%q) = %v, %v; want 9090, nil", "TEST_LAYERED", got, err)
+		}
+	})
+
+	t.Run("FallbackUsed", func(t *testing.T) {
+		os.Unsetenv("TEST_LAYERED")
+		if got, err := ReadEnvLayered("TEST_LAYERED", fallbacks, 80); got != 8080 || err != nil {
+			t.Errorf("ReadEnvLayered(%q) = %v, %v; want 8080, nil", "TEST_LAYERED", got, err)
+		}
+	})
+
+	t.Run("EmptyEnv_FallbackUsed", func(t *testing.T) {
+		t.Setenv("TEST_LAYERED", "")
+		if got, err := ReadEnvLayered("TEST_LAYERED", fallbacks, 80); got != 8080 || err != nil {
+			t.Errorf("ReadEnvLayered(%q) = %v, %v; want 8080, nil", "TEST_LAYERED", got, err)
+		}
+	})
+
+	t.Run("DefaultUsed", func(t *testing.T) {
+		os.Unsetenv("TEST_LAYERED_MISSING")
+		if got, err := ReadEnvLayered("TEST_LAYERED_MISSING", fallbacks, 80); got != 80 || err != nil {
+			t.Errorf("ReadEnvLayered(%q) = %v, %v; want 80, nil", "TEST_LAYERED_MISSING", got, err)
+		}
+		if got, err := ReadEnvLayered("TEST_LAYERED_MISSING", nil, 80); got != 80 || err != nil {
+			t.Errorf("ReadEnvLayered(%q, nil) = %v, %v; want 80, nil", "TEST_LAYERED_MISSING", got, err)
+		}
+	})
+
+	t.Run("InvalidFallback", func(t *testing.T) {
+		os.Unsetenv("TEST_LAYERED_BAD")
+		got, err := ReadEnvLayered("TEST_LAYERED_BAD", fallbacks, 80)
+		var convErr *ConversionError
+		if got != 80 || !errors.As(err, &convErr) || convErr.Key != "TEST_LAYERED_BAD" {
+			t.Errorf("ReadEnvLayered(%q) = %v, %v; want 80 and a ConversionError for the key", "TEST_LAYERED_BAD", got, err)
+		}
+	})
+}
+
+func TestReadEnvWithSource(t *testing.T) {
+	t.Run("ParsedFromEnv", func(t *testing.T) {
+		t.Setenv("TEST_SOURCE", "8080")
+		val, fromEnv, err := ReadEnvWithSource("TEST_SOURCE", 80)
+		if err != nil || val != 8080 || !fromEnv {
+			t.Errorf("ReadEnvWithSource(%q, 80) = %v, %v, %v; want 8080, true, nil", "TEST_SOURCE", val, fromEnv, err)
+		}
+	})
+
+	t.Run("Unset_UsesDefault", func(t *testing.T) {
+		os.Unsetenv("TEST_SOURCE")
+		val, fromEnv, err := ReadEnvWithSource("TEST_SOURCE", 80)
+		if err != nil || val != 80 || fromEnv {
+			t.Errorf("ReadEnvWithSource(%q, 80) = %v, %v, %v; want 80, false, nil", "TEST_SOURCE", val, fromEnv, err)
+		}
+	})
+
+	t.Run("Empty_UsesDefault", func(t *testing.T) {
+		t.Setenv("TEST_SOURCE", "")
+		val, fromEnv, err := ReadEnvWithSource("TEST_SOURCE", 80)
+		if err != nil || val != 80 || fromEnv {
+			t.Errorf("ReadEnvWithSource(%q, 80) = %v, %v, %v; want 80, false, nil", "TEST_SOURCE", val, fromEnv, err)
+		}
+	})
+
+	t.Run("Invalid_UsesDefault", func(t *testing.T) {
+		t.Setenv("TEST_SOURCE", "abc")
+		val, fromEnv, err := ReadEnvWithSource("TEST_SOURCE", 80)
+		if !errors.Is(err, strconv.ErrSyntax) || val != 80 || fromEnv {
+			t.Errorf("ReadEnvWithSource(%q, 80) = %v, %v, %v; want 80, false, strconv.ErrSyntax", "TEST_SOURCE", val, fromEnv, err)
+		}
+	})
+}
+
+func TestReadEnvFrom(t *testing.T) {
+	env := map[string]string{
+		"TEST_FROM_INT":     "42",
+		"TEST_FROM_INVALID": "abc",
+		"TEST_FROM_EMPTY":   "",
+	}
+	lookup := func(key string) (string, bool) {
+		val, ok := env[key]
+		return val, ok
+	}
+
+	t.Run("Int_FromMap", func(t *testing.T) {
+		t.Parallel()
+		got, err := ReadEnvFrom(lookup, "TEST_FROM_INT", 0)
+		if err != nil || got != 42 {
+			t.Errorf("ReadEnvFrom(%q, 0) = %v, %v; want 42, nil", "TEST_FROM_INT", got, err)
+		}
+	})
+
+	t.Run("Missing_ReturnsDefault", func(t *testing.T) {
+		t.Parallel()
+		got, err := ReadEnvFrom(lookup, "TEST_FROM_MISSING", 7)
+		if err != nil || got != 7 {
+			t.Errorf("ReadEnvFrom(%q, 7) = %v, %v; want 7, nil", "TEST_FROM_MISSING", got, err)
+		}
+	})
+
+	t.Run("String_EmptyValue", func(t *testing.T) {
+		t.Parallel()
+		got, err := ReadEnvFrom(lookup, "TEST_FROM_EMPTY", "default")
+		if err != nil || got != "" {
+			t.Errorf("ReadEnvFrom(%q, %q) = %q, %v; want \"\", nil", "TEST_FROM_EMPTY", "default", got, err)
+		}
+	})
+
+	t.Run("Invalid_ReturnsDefault", func(t *testing.T) {
+		t.Parallel()
+		got, err := ReadEnvFrom(lookup, "TEST_FROM_INVALID", 7)
+		if !errors.Is(err, strconv.ErrSyntax) || got != 7 {
+			t.Errorf("ReadEnvFrom(%q, 7) = %v, %v; want 7, strconv.ErrSyntax", "TEST_FROM_INVALID", got, err)
+		}
+	})
+
+	t.Run("ProcessEnvUntouched", func(t *testing.T) {
+		t.Parallel()
+		if _, ok := os.LookupEnv("TEST_FROM_INT"); ok {
+			t.Errorf("ReadEnvFrom leaked %q into the process environment", "TEST_FROM_INT")
+		}
+	})
+}
+
+func TestReadEnvZero(t *testing.T) {
+	t.Run("Unset", func(t *testing.T) {
+		os.Unsetenv("TEST_ZERO")
+		if got, err := ReadEnvZero[int]("TEST_ZERO"); got != 0 || err != nil {
+			t.Errorf("ReadEnvZero[int] = %v, %v; want 0, nil", got, err)
+		}
+		if got, err := ReadEnvZero[string]("TEST_ZERO"); got != "" || err != nil {
+			t.Errorf("ReadEnvZero[string] = %q, %v; want \"\", nil", got, err)
+		}
+		if got, err := ReadEnvZero[bool]("TEST_ZERO"); got || err != nil {
+			t.Errorf("ReadEnvZero[bool] = %v, %v; want false, nil", got, err)
+		}
+		if got, err := ReadEnvZero[float64]("TEST_ZERO"); got != 0.0 || err != nil {
+			t.Errorf("ReadEnvZero[float64] = %v, %v; want 0, nil", got, err)
+		}
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		t.Setenv("TEST_ZERO", "12")
+		if got, err := ReadEnvZero[int]("TEST_ZERO"); got != 12 || err != nil {
+			t.Errorf("ReadEnvZero[int] = %v, %v; want 12, nil", got, err)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Setenv("TEST_ZERO", "abc")
+		if got, err := ReadEnvZero[int]("TEST_ZERO"); got != 0 || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvZero[int] = %v, %v; want 0, strconv.ErrSyntax", got, err)
+		}
+		if got, err := ReadEnvZero[bool]("TEST_ZERO"); got || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvZero[bool] = %v, %v; want false, strconv.ErrSyntax", got, err)
+		}
+		if got, err := ReadEnvZero[float64]("TEST_ZERO"); got != 0.0 || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvZero[float64] = %v, %v; want 0, strconv.ErrSyntax", got, err)
+		}
+	})
+}
+
+func TestReadEnvNoFallback(t *testing.T) {
+	t.Run("Unset_ReturnsDefault", func(t *testing.T) {
+		os.Unsetenv("TEST_NO_FALLBACK")
+		if got, err := ReadEnvNoFallback("TEST_NO_FALLBACK", 30); got != 30 || err != nil {
+			t.Errorf("ReadEnvNoFallback = %v, %v; want 30, nil", got, err)
+		}
+	})
+
+	t.Run("Empty_ReturnsDefault", func(t *testing.T) {
+		t.Setenv("TEST_NO_FALLBACK", "")
+		if got, err := ReadEnvNoFallback("TEST_NO_FALLBACK", 30); got != 30 || err != nil {
+			t.Errorf("ReadEnvNoFallback = %v, %v; want 30, nil", got, err)
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		t.Setenv("TEST_NO_FALLBACK", "45")
+		if got, err := ReadEnvNoFallback("TEST_NO_FALLBACK", 30); got != 45 || err != nil {
+			t.Errorf("ReadEnvNoFallback = %v, %v; want 45, nil", got, err)
+		}
+	})
+
+	t.Run("Invalid_ReturnsZero", func(t *testing.T) {
+		t.Setenv("TEST_NO_FALLBACK", "thirty")
+		if got, err := ReadEnvNoFallback("TEST_NO_FALLBACK", 30); got != 0 || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvNoFallback = %v, %v; want 0, strconv.ErrSyntax", got, err)
+		}
+		if got, err := ReadEnvNoFallback("TEST_NO_FALLBACK", 5*time.Second); got != 0 || err == nil {
+			t.Errorf("ReadEnvNoFallback[time.Duration] = %v, %v; want 0 and an error", got, err)
+		}
+	})
+}
+
+func TestReadEnvIndirect(t *testing.T) {
+	t.Run("ValidChain", func(t *testing.T) {
+		t.Setenv("TEST_INDIRECT_SOURCE", "TEST_INDIRECT_TARGET")
+		t.Setenv("TEST_INDIRECT_TARGET", "5432")
+		if got, err := ReadEnvIndirect("TEST_INDIRECT_SOURCE", 1); got != 5432 || err != nil {
+			t.Errorf("ReadEnvIndirect = %v, %v; want 5432, nil", got, err)
+		}
+	})
+
+	t.Run("DanglingTarget", func(t *testing.T) {
+		t.Setenv("TEST_INDIRECT_SOURCE", "TEST_INDIRECT_MISSING")
+		os.Unsetenv("TEST_INDIRECT_MISSING")
+		got, err := ReadEnvIndirect("TEST_INDIRECT_SOURCE", 1)
+		if got != 1 || !errors.Is(err, ErrMissingRequired) {
+			t.Fatalf("ReadEnvIndirect = %v, %v; want 1, ErrMissingRequired", got, err)
+		}
+		want := `required environment variable is not set: "TEST_INDIRECT_MISSING" (named by "TEST_INDIRECT_SOURCE")`
+		if err.Error() != want {
+			t.Errorf("ReadEnvIndirect error = %q; want %q", err, want)
+		}
+	})
+
+	t.Run("InvalidTargetValue", func(t *testing.T) {
+		t.Setenv("TEST_INDIRECT_SOURCE", "TEST_INDIRECT_TARGET")
+		t.Setenv("TEST_INDIRECT_TARGET", "abc")
+		got, err := ReadEnvIndirect("TEST_INDIRECT_SOURCE", 1)
+		var convErr *ConversionError
+		if got != 1 || !errors.As(err, &convErr) || convErr.Key != "TEST_INDIRECT_TARGET" {
+			t.Errorf("ReadEnvIndirect = %v, %v; want 1 and a ConversionError for the target", got, err)
+		}
+	})
+
+	t.Run("SourceUnset", func(t *testing.T) {
+		os.Unsetenv("TEST_INDIRECT_SOURCE")
+		if got, err := ReadEnvIndirect("TEST_INDIRECT_SOURCE", "fallback"); got != "fallback" || err != nil {
+			t.Errorf("ReadEnvIndirect = %q, %v; want %q, nil", got, err, "fallback")
+		}
+	})
+}
+
+func TestReadEnvAny(t *testing.T) {
+	t.Run("FirstKeyWins", func(t *testing.T) {
+		t.Setenv("TEST_ANY_NEW", "new")
+		t.Setenv("TEST_ANY_OLD", "old")
+		got, err := ReadEnvAny("default", "TEST_ANY_NEW", "TEST_ANY_OLD")
+		if err != nil || got != "new" {
+			t.Errorf("ReadEnvAny = %q, %v; want %q, nil", got, err, "new")
+		}
+	})
+
+	t.Run("SecondKeyFallback", func(t *testing.T) {
+		os.Unsetenv("TEST_ANY_NEW")
+		t.Setenv("TEST_ANY_OLD", "old")
+		got, err := ReadEnvAny("default", "TEST_ANY_NEW", "TEST_ANY_OLD")
+		if err != nil || got != "old" {
+			t.Errorf("ReadEnvAny = %q, %v; want %q, nil", got, err, "old")
+		}
+	})
+
+	t.Run("EmptyFirstKeySkipped", func(t *testing.T) {
+		t.Setenv("TEST_ANY_NEW", "")
+		t.Setenv("TEST_ANY_OLD", "old")
+		got, err := ReadEnvAny("default", "TEST_ANY_NEW", "TEST_ANY_OLD")
+		if err != nil || got != "old" {
+			t.Errorf("ReadEnvAny = %q, %v; want %q, nil", got, err, "old")
+		}
+	})
+
+	t.Run("NoneSet", func(t *testing.T) {
+		os.Unsetenv("TEST_ANY_NEW")
+		os.Unsetenv("TEST_ANY_OLD")
+		got, err := ReadEnvAny(5, "TEST_ANY_NEW", "TEST_ANY_OLD")
+		if err != nil || got != 5 {
+			t.Errorf("ReadEnvAny = %v, %v; want 5, nil", got, err)
+		}
+	})
+
+	t.Run("FirstKeyInvalid_ReturnsError", func(t *testing.T) {
+		t.Setenv("TEST_ANY_NEW", "abc")
+		t.Setenv("TEST_ANY_OLD", "10")
+		got, err := ReadEnvAny(5, "TEST_ANY_NEW", "TEST_ANY_OLD")
+		if !errors.Is(err, strconv.ErrSyntax) || got != 5 {
+			t.Errorf("ReadEnvAny = %v, %v; want 5, strconv.ErrSyntax", got, err)
+		}
+	})
+}
+
+func TestReadEnvValidated(t *testing.T) {
+	errNegative := errors.New("must not be negative")
+	nonNegative := func(v int) error {
+		if v < 0 {
+			return errNegative
+		}
+		return nil
+	}
+	minLength := func(v string) error {
+		if len(v) < 8 {
+			return fmt.Errorf("length %d is shorter than 8", len(v))
+		}
+		return nil
+	}
+
+	t.Run("Int_Valid", func(t *testing.T) {
+		t.Setenv("TEST_VALIDATED", "3")
+		got, err := ReadEnvValidated("TEST_VALIDATED", 1, nonNegative)
+		if err != nil || got != 3 {
+			t.Errorf("ReadEnvValidated = %v, %v; want 3, nil", got, err)
+		}
+	})
+
+	t.Run("Int_Rejected", func(t *testing.T) {
+		t.Setenv("TEST_VALIDATED", "-3")
+		got, err := ReadEnvValidated("TEST_VALIDATED", 1, nonNegative)
+		if got != 1 || !errors.Is(err, errNegative) {
+			t.Errorf("ReadEnvValidated = %v, %v; want 1, errNegative", got, err)
+		}
+		want := `invalid value for "TEST_VALIDATED": must not be negative`
+		if err != nil && err.Error() != want {
+			t.Errorf("ReadEnvValidated error = %q; want %q", err, want)
+		}
+	})
+
+	t.Run("Int_Unparseable", func(t *testing.T) {
+		t.Setenv("TEST_VALIDATED", "abc")
+		got, err := ReadEnvValidated("TEST_VALIDATED", 1, nonNegative)
+		if got != 1 || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvValidated = %v, %v; want 1, strconv.ErrSyntax", got, err)
+		}
+	})
+
+	t.Run("String_MinLength", func(t *testing.T) {
+		t.Setenv("TEST_VALIDATED", "short")
+		got, err := ReadEnvValidated("TEST_VALIDATED", "long-enough-default", minLength)
+		if got != "long-enough-default" || err == nil {
+			t.Errorf("ReadEnvValidated = %q, %v; want default and an error", got, err)
+		}
+	})
+
+	t.Run("Unset_ValidatorNotCalled", func(t *testing.T) {
+		os.Unsetenv("TEST_VALIDATED")
+		called := false
+		got, err := ReadEnvValidated("TEST_VALIDATED", -1, func(int) error {
+			called = true
+			return errNegative
+		})
+		if err != nil || got != -1 || called {
+			t.Errorf("ReadEnvValidated = %v, %v (validator called: %v); want -1, nil, not called", got, err, called)
+		}
+	})
+}
+
+func TestReadEnvContext(t *testing.T) {
+	osLookup := func(_ context.Context, key string) (string, bool) {
+		return os.LookupEnv(key)
+	}
+
+	t.Run("OSLookup", func(t *testing.T) {
+		t.Setenv("TEST_CONTEXT", "12")
+		got, err := ReadEnvContext(context.Background(), osLookup, "TEST_CONTEXT", 0)
+		if err != nil || got != 12 {
+			t.Errorf("ReadEnvContext = %v, %v; want 12, nil", got, err)
+		}
+	})
+
+	t.Run("Missing_ReturnsDefault", func(t *testing.T) {
+		os.Unsetenv("TEST_CONTEXT")
+		got, err := ReadEnvContext(context.Background(), osLookup, "TEST_CONTEXT", 3)
+		if err != nil || got != 3 {
+			t.Errorf("ReadEnvContext = %v, %v; want 3, nil", got, err)
+		}
+	})
+
+	t.Run("BlockingLookup_DeadlineExceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		blocking := func(ctx context.Context, _ string) (string, bool) {
+			<-ctx.Done()
+			return "", false
+		}
+
+		got, err := ReadEnvContext(ctx, blocking, "TEST_CONTEXT", 3)
+		if !errors.Is(err, context.DeadlineExceeded) || got != 3 {
+			t.Errorf("ReadEnvContext = %v, %v; want 3, context.DeadlineExceeded", got, err)
+		}
+	})
+
+	t.Run("AlreadyCancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		called := false
+		lookup := func(context.Context, string) (string, bool) {
+			called = true
+			return "1", true
+		}
+
+		got, err := ReadEnvContext(ctx, lookup, "TEST_CONTEXT", 3)
+		if !errors.Is(err, context.Canceled) || got != 3 || called {
+			t.Errorf("ReadEnvContext = %v, %v (lookup called: %v); want 3, context.Canceled, not called", got, err, called)
+		}
+	})
+}
+
+func TestHooks(t *testing.T) {
+	var defaultKeys []string
+	var errorKeys []string
+	var errs []error
+	OnDefault = func(key string) { defaultKeys = append(defaultKeys, key) }
+	OnError = func(key string, err error) {
+		errorKeys = append(errorKeys, key)
+		errs = append(errs, err)
+	}
+	t.Cleanup(func() {
+		OnDefault = nil
+		OnError = nil
+	})
+	reset := func() { defaultKeys, errorKeys, errs = nil, nil, nil }
+
+	t.Run("Unset_CallsOnDefault", func(t *testing.T) {
+		reset()
+		os.Unsetenv("TEST_HOOK")
+		if got, err := ReadEnv("TEST_HOOK", 5); got != 5 || err != nil {
+			t.Errorf("ReadEnv = %v, %v; want 5, nil", got, err)
+		}
+		if !reflect.DeepEqual(defaultKeys, []string{"TEST_HOOK"}) || errorKeys != nil {
+			t.Errorf("OnDefault keys = %v, OnError keys = %v; want [TEST_HOOK], []", defaultKeys, errorKeys)
+		}
+	})
+
+	t.Run("Empty_CallsOnDefault", func(t *testing.T) {
+		reset()
+		t.Setenv("TEST_HOOK", "")
+		ReadEnv("TEST_HOOK", 5)
+		if !reflect.DeepEqual(defaultKeys, []string{"TEST_HOOK"}) {
+			t.Errorf("OnDefault keys = %v; want [TEST_HOOK]", defaultKeys)
+		}
+	})
+
+	t.Run("InvalidValue_CallsOnError", func(t *testing.T) {
+		reset()
+		t.Setenv("TEST_HOOK", "abc")
+		got, err := ReadEnv("TEST_HOOK", 5)
+		if got != 5 || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnv = %v, %v; want 5, strconv.ErrSyntax", got, err)
+		}
+		if !reflect.DeepEqual(errorKeys, []string{"TEST_HOOK"}) || defaultKeys != nil {
+			t.Errorf("OnError keys = %v, OnDefault keys = %v; want [TEST_HOOK], []", errorKeys, defaultKeys)
+		}
+		if len(errs) != 1 || errs[0] != err {
+			t.Errorf("OnError errors = %v; want [%v]", errs, err)
+		}
+	})
+
+	t.Run("ValidValue_NoHooks", func(t *testing.T) {
+		reset()
+		t.Setenv("TEST_HOOK", "6")
+		ReadEnv("TEST_HOOK", 5)
+		if defaultKeys != nil || errorKeys != nil {
+			t.Errorf("OnDefault keys = %v, OnError keys = %v; want none", defaultKeys, errorKeys)
+		}
+	})
+}
+
+func TestReadString(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		envValue string
+		setEnv   bool
+	}{
+		{name: "EnvExists", envValue: "value", setEnv: true},
+		{name: "EnvExists_EmptyValue", envValue: "", setEnv: true},
+		{name: "EnvNotExists", setEnv: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_READ_STRING", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_READ_STRING")
+			}
+
+			want, wantErr := ReadEnv("TEST_READ_STRING", "fallback")
+			if got := ReadString("TEST_READ_STRING", "fallback"); got != want {
+				t.Errorf("ReadString(%q) = %q; want %q as from ReadEnv", tt.envValue, got, want)
+			}
+			if got, err := ReadStringErr("TEST_READ_STRING", "fallback"); got != want || err != wantErr {
+				t.Errorf("ReadStringErr(%q) = %q, %v; want %q, %v as from ReadEnv", tt.envValue, got, err, want, wantErr)
+			}
+		})
+	}
+
+	t.Run("OnDefault", func(t *testing.T) {
+		os.Unsetenv("TEST_READ_STRING")
+		var called []string
+		OnDefault = func(key string) { called = append(called, key) }
+		t.Cleanup(func() { OnDefault = nil })
+
+		ReadString("TEST_READ_STRING", "fallback")
+		if len(called) != 1 || called[0] != "TEST_READ_STRING" {
+			t.Errorf("OnDefault called with %v; want [TEST_READ_STRING]", called)
+		}
+	})
+}
+
+func BenchmarkReadEnvString(b *testing.B) {
+	b.Setenv("BENCH_READ_STRING", "a reasonably long configuration value")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ReadEnv("BENCH_READ_STRING", "fallback")
+	}
+}
+
+func BenchmarkReadString(b *testing.B) {
+	b.Setenv("BENCH_READ_STRING", "a reasonably long configuration value")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ReadString("BENCH_READ_STRING", "fallback")
+	}
+}
+
+func TestReadEnvFold(t *testing.T) {
+	t.Run("ExactMatch", func(t *testing.T) {
+		t.Setenv("TEST_FOLD_PORT", "8080")
+		t.Setenv("test_fold_port", "9090")
+		got, err := ReadEnvFold("TEST_FOLD_PORT", 0)
+		if err != nil || got != 8080 {
+			t.Errorf("ReadEnvFold = %v, %v; want 8080, nil", got, err)
+		}
+	})
+
+	t.Run("DifferentCase", func(t *testing.T) {
+		os.Unsetenv("TEST_FOLD_HOST")
+		t.Setenv("test_Fold_host", "example.com")
+		got, err := ReadEnvFold("TEST_FOLD_HOST", "localhost")
+		if err != nil || got != "example.com" {
+			t.Errorf("ReadEnvFold = %q, %v; want %q, nil", got, err, "example.com")
+		}
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		got, err := ReadEnvFold("TEST_FOLD_MISSING", 3)
+		if err != nil || got != 3 {
+			t.Errorf("ReadEnvFold = %v, %v; want 3, nil", got, err)
+		}
+	})
+
+	t.Run("StubbedLookupFunc", func(t *testing.T) {
+		t.Setenv("TEST_FOLD_REAL", "42")
+		t.Setenv("test_fold_other", "7")
+		LookupFunc = func(key string) (string, bool) {
+			if key == "TEST_FOLD_STUB" {
+				return "9", true
+			}
+			return "", false
+		}
+		t.Cleanup(func() { LookupFunc = nil })
+
+		if got, err := ReadEnvFold("TEST_FOLD_REAL", 0); err != nil || got != 0 {
+			t.Errorf("ReadEnvFold(%q) = %v, %v; want 0, nil", "TEST_FOLD_REAL", got, err)
+		}
+		if got, err := ReadEnvFold("TEST_FOLD_OTHER", 0); err != nil || got != 0 {
+			t.Errorf("ReadEnvFold(%q) = %v, %v; want 0, nil", "TEST_FOLD_OTHER", got, err)
+		}
+		if got, err := ReadEnvFold("TEST_FOLD_STUB", 0); err != nil || got != 9 {
+			t.Errorf("ReadEnvFold(%q) = %v, %v; want 9, nil", "TEST_FOLD_STUB", got, err)
+		}
+	})
+
+	t.Run("RestoredLookupFunc", func(t *testing.T) {
+		t.Setenv("test_fold_restored", "5")
+		LookupFunc = func(key string) (string, bool) { return "", false }
+		LookupFunc = nil
+
+		if got, err := ReadEnvFold("TEST_FOLD_RESTORED", 0); err != nil || got != 5 {
+			t.Errorf("ReadEnvFold(%q) = %v, %v; want 5, nil", "TEST_FOLD_RESTORED", got, err)
+		}
+	})
+}
+
+func TestReadEnvPtr(t *testing.T) {
+	t.Run("Int_Unset", func(t *testing.T) {
+		os.Unsetenv("TEST_PTR")
+		if got, err := ReadEnvPtr[int]("TEST_PTR"); got != nil || err != nil {
+			t.Errorf("ReadEnvPtr[int] = %v, %v; want nil, nil", got, err)
+		}
+	})
+
+	t.Run("Int_Zero", func(t *testing.T) {
+		t.Setenv("TEST_PTR", "0")
+		got, err := ReadEnvPtr[int]("TEST_PTR")
+		if err != nil || got == nil || *got != 0 {
+			t.Errorf("ReadEnvPtr[int] = %v, %v; want pointer to 0, nil", got, err)
+		}
+	})
+
+	t.Run("Int_Invalid", func(t *testing.T) {
+		t.Setenv("TEST_PTR", "abc")
+		got, err := ReadEnvPtr[int]("TEST_PTR")
+		if got != nil || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvPtr[int] = %v, %v; want nil, strconv.ErrSyntax", got, err)
+		}
+	})
+
+	t.Run("String_Unset", func(t *testing.T) {
+		os.Unsetenv("TEST_PTR")
+		if got, err := ReadEnvPtr[string]("TEST_PTR"); got != nil || err != nil {
+			t.Errorf("ReadEnvPtr[string] = %v, %v; want nil, nil", got, err)
+		}
+	})
+
+	t.Run("String_Empty", func(t *testing.T) {
+		t.Setenv("TEST_PTR", "")
+		if got, err := ReadEnvPtr[string]("TEST_PTR"); got != nil || err != nil {
+			t.Errorf("ReadEnvPtr[string] = %v, %v; want nil, nil", got, err)
+		}
+	})
+
+	t.Run("String_Set", func(t *testing.T) {
+		t.Setenv("TEST_PTR", "value")
+		got, err := ReadEnvPtr[string]("TEST_PTR")
+		if err != nil || got == nil || *got != "value" {
+			t.Errorf("ReadEnvPtr[string] = %v, %v; want pointer to %q, nil", got, err, "value")
+		}
+	})
+}
+
+func TestReadEnvStrict(t *testing.T) {
+	t.Run("Unset_NoError", func(t *testing.T) {
+		os.Unsetenv("TEST_STRICT")
+		if got, err := ReadEnvStrict("TEST_STRICT", 3); got != 3 || err != nil {
+			t.Errorf("ReadEnvStrict = %v, %v; want 3, nil", got, err)
+		}
+	})
+
+	t.Run("SetEmpty_Error", func(t *testing.T) {
+		t.Setenv("TEST_STRICT", "")
+		got, err := ReadEnvStrict("TEST_STRICT", 3)
+		want := `environment variable "TEST_STRICT" is set but empty`
+		if got != 3 || err == nil || err.Error() != want {
+			t.Errorf("ReadEnvStrict = %v, %v; want 3, %q", got, err, want)
+		}
+	})
+
+	t.Run("SetEmpty_String_Error", func(t *testing.T) {
+		t.Setenv("TEST_STRICT", "")
+		if got, err := ReadEnvStrict("TEST_STRICT", "default"); got != "default" || err == nil {
+			t.Errorf("ReadEnvStrict = %q, %v; want %q and an error", got, err, "default")
+		}
+	})
+
+	t.Run("SetValid_NoError", func(t *testing.T) {
+		t.Setenv("TEST_STRICT", "4")
+		if got, err := ReadEnvStrict("TEST_STRICT", 3); got != 4 || err != nil {
+			t.Errorf("ReadEnvStrict = %v, %v; want 4, nil", got, err)
+		}
+	})
+}
+
+func TestGetRaw(t *testing.T) {
+	t.Run("Set", func(t *testing.T) {
+		t.Setenv("TEST_RAW", " 42 ")
+		if value, present := GetRaw("TEST_RAW"); value != " 42 " || !present {
+			t.Errorf("GetRaw = %q, %v; want %q, true", value, present, " 42 ")
+		}
+	})
+
+	t.Run("SetEmpty", func(t *testing.T) {
+		t.Setenv("TEST_RAW", "")
+		if value, present := GetRaw("TEST_RAW"); value != "" || !present {
+			t.Errorf("GetRaw = %q, %v; want \"\", true", value, present)
+		}
+	})
+
+	t.Run("Unset", func(t *testing.T) {
+		os.Unsetenv("TEST_RAW")
+		if value, present := GetRaw("TEST_RAW"); value != "" || present {
+			t.Errorf("GetRaw = %q, %v; want \"\", false", value, present)
+		}
+	})
+}
+
+func TestLookupFunc(t *testing.T) {
+	t.Setenv("TEST_LOOKUP_FUNC", "from-process")
+	env := map[string]string{"TEST_LOOKUP_FUNC": "from-map", "TEST_LOOKUP_FUNC_INT": "7"}
+	LookupFunc = func(key string) (string, bool) {
+		val, ok := env[key]
+		return val, ok
+	}
+	t.Cleanup(func() { LookupFunc = nil })
+
+	if got, _ := ReadEnv("TEST_LOOKUP_FUNC", ""); got != "from-map" {
+		t.Errorf("ReadEnv = %q; want %q", got, "from-map")
+	}
+	if got, _ := ReadEnvIntRange("TEST_LOOKUP_FUNC_INT", 0, 1, 10); got != 7 {
+		t.Errorf("ReadEnvIntRange = %d; want 7", got)
+	}
+	if _, ok := os.LookupEnv("TEST_LOOKUP_FUNC_INT"); ok {
+		t.Errorf("LookupFunc leaked %q into the process environment", "TEST_LOOKUP_FUNC_INT")
+	}
+
+	LookupFunc = nil
+	if got, _ := ReadEnv("TEST_LOOKUP_FUNC", ""); got != "from-process" {
+		t.Errorf("ReadEnv after restore = %q; want %q", got, "from-process")
+	}
+}
+
+func TestReadEnvNormalized(t *testing.T) {
+	upper := strings.ToUpper
+
+	t.Run("String", func(t *testing.T) {
+		t.Setenv("TEST_NORMALIZED", "eu-west-1")
+		if got, err := ReadEnvNormalized("TEST_NORMALIZED", "", upper); got != "EU-WEST-1" || err != nil {
+			t.Errorf("ReadEnvNormalized[string] = %q, %v; want %q, nil", got, err, "EU-WEST-1")
+		}
+	})
+
+	t.Run("Bool_MixedCase", func(t *testing.T) {
+		// strconv.ParseBool rejects "tRuE" but accepts "TRUE".
+		t.Setenv("TEST_NORMALIZED", "tRuE")
+		if _, err := ReadEnv("TEST_NORMALIZED", false); err == nil {
+			t.Fatalf("ReadEnv[bool](%q) returned nil error; want the unnormalized value rejected", "tRuE")
+		}
+		if got, err := ReadEnvNormalized("TEST_NORMALIZED", false, upper); !got || err != nil {
+			t.Errorf("ReadEnvNormalized[bool](%q) = %v, %v; want true, nil", "tRuE", got, err)
+		}
+	})
+
+	t.Run("TrimAndLower", func(t *testing.T) {
+		t.Setenv("TEST_NORMALIZED", "  Debug ")
+		normalize := func(s string) string { return strings.ToLower(strings.TrimSpace(s)) }
+		if got, err := ReadEnvNormalized("TEST_NORMALIZED", "info", normalize); got != "debug" || err != nil {
+			t.Errorf("ReadEnvNormalized[string] = %q, %v; want %q, nil", got, err, "debug")
+		}
+	})
+
+	t.Run("NilNormalizer", func(t *testing.T) {
+		t.Setenv("TEST_NORMALIZED", " raw ")
+		if got, err := ReadEnvNormalized("TEST_NORMALIZED", "", nil); got != " raw " || err != nil {
+			t.Errorf("ReadEnvNormalized[string] = %q, %v; want %q, nil", got, err, " raw ")
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Setenv("TEST_NORMALIZED", "yes")
+		if got, err := ReadEnvNormalized("TEST_NORMALIZED", false, upper); got || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvNormalized[bool](%q) = %v, %v; want false, strconv.ErrSyntax", "yes", got, err)
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_NORMALIZED")
+		if got, err := ReadEnvNormalized("TEST_NORMALIZED", 7, nil); got != 7 || err != nil {
+			t.Errorf("ReadEnvNormalized[int] = %v, %v; want 7, nil", got, err)
+		}
+	})
+}
+
+func TestReadEnvNullable(t *testing.T) {
+	tests := []struct {
+		name        string
+		envValue    string
+		nullStrings []string
+		expectedVal int
+		expectedErr error
+	}{
+		{name: "Null", envValue: "null", expectedVal: 5},
+		{name: "Nil", envValue: "nil", expectedVal: 5},
+		{name: "None_Uppercase", envValue: "NONE", expectedVal: 5},
+		{name: "RealValue", envValue: "42", expectedVal: 42},
+		{name: "Empty", envValue: "", expectedVal: 5},
+		{name: "Custom", envValue: "N/A", nullStrings: []string{"n/a", "-"}, expectedVal: 5},
+		{name: "Custom_ReplacesDefaults", envValue: "null", nullStrings: []string{"n/a"}, expectedVal: 5, expectedErr: strconv.ErrSyntax},
+		{name: "Invalid", envValue: "nothing", expectedVal: 5, expectedErr: strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_NULLABLE", tt.envValue)
+
+			got, err := ReadEnvNullable("TEST_NULLABLE", 5, tt.nullStrings...)
+			if got != tt.expectedVal {
+				t.Errorf("ReadEnvNullable(%q, %q) returned value %v; want %v", tt.envValue, tt.nullStrings, got, tt.expectedVal)
+			}
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("ReadEnvNullable(%q, %q) returned error %v; want %v", tt.envValue, tt.nullStrings, err, tt.expectedErr)
+			}
+		})
+	}
+
+	t.Run("String_Null", func(t *testing.T) {
+		t.Setenv("TEST_NULLABLE", "Null")
+		if got, err := ReadEnvNullable("TEST_NULLABLE", "fallback"); got != "fallback" || err != nil {
+			t.Errorf("ReadEnvNullable[string](%q) = %q, %v; want %q, nil", "Null", got, err, "fallback")
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_NULLABLE")
+		if got, err := ReadEnvNullable("TEST_NULLABLE", 5); got != 5 || err != nil {
+			t.Errorf("ReadEnvNullable = %v, %v; want 5, nil", got, err)
+		}
+	})
+}
+
+func TestReadEnvUnquote(t *testing.T) {
+	tests := []struct {
+		name        string
+		envValue    string
+		setEnv      bool
+		expectedVal string
+		expectedErr error
+	}{
+		{name: "DoubleQuoted", envValue: `"John Doe"`, setEnv: true, expectedVal: "John Doe"},
+		{name: "DoubleQuoted_Escapes", envValue: `"tab\there \"x\""`, setEnv: true, expectedVal: "tab\there \"x\""},
+		{name: "SingleQuoted", envValue: `'John Doe'`, setEnv: true, expectedVal: "John Doe"},
+		{name: "SingleQuoted_Literal", envValue: `'a\nb'`, setEnv: true, expectedVal: `a\nb`},
+		{name: "Unquoted", envValue: `John "Johnny" Doe`, setEnv: true, expectedVal: `John "Johnny" Doe`},
+		{name: "Empty", envValue: "", setEnv: true, expectedVal: ""},
+		{name: "Unset", setEnv: false, expectedVal: "fallback"},
+		{name: "DoubleQuoted_Unterminated", envValue: `"John Doe`, setEnv: true, expectedVal: "fallback", expectedErr: strconv.ErrSyntax},
+		{name: "SingleQuoted_Unterminated", envValue: `'John Doe`, setEnv: true, expectedVal: "fallback", expectedErr: strconv.ErrSyntax},
+		{name: "SingleQuote_Only", envValue: `'`, setEnv: true, expectedVal: "fallback", expectedErr: strconv.ErrSyntax},
+		{name: "MismatchedQuotes", envValue: `"John Doe'`, setEnv: true, expectedVal: "fallback", expectedErr: strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_UNQUOTE", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_UNQUOTE")
+			}
+
+			got, err := ReadEnvUnquote("TEST_UNQUOTE", "fallback")
+			if got != tt.expectedVal {
+				t.Errorf("ReadEnvUnquote(%q) returned value %q; want %q", tt.envValue, got, tt.expectedVal)
+			}
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("ReadEnvUnquote(%q) returned error %v; want %v", tt.envValue, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestLookupEnvTyped(t *testing.T) {
+	t.Run("Int", func(t *testing.T) {
+		os.Unsetenv("TEST_LOOKUP_TYPED")
+		if v, present, err := LookupEnvTyped[int]("TEST_LOOKUP_TYPED"); v != 0 || present || err != nil {
+			t.Errorf("absent: got %v, %v, %v; want 0, false, nil", v, present, err)
+		}
+
+		t.Setenv("TEST_LOOKUP_TYPED", "12")
+		if v, present, err := LookupEnvTyped[int]("TEST_LOOKUP_TYPED"); v != 12 || !present || err != nil {
+			t.Errorf("valid: got %v, %v, %v; want 12, true, nil", v, present, err)
+		}
+
+		t.Setenv("TEST_LOOKUP_TYPED", "twelve")
+		if v, present, err := LookupEnvTyped[int]("TEST_LOOKUP_TYPED"); v != 0 || !present || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("invalid: got %v, %v, %v; want 0, true, strconv.ErrSyntax", v, present, err)
+		}
+	})
+
+	t.Run("Bool", func(t *testing.T) {
+		os.Unsetenv("TEST_LOOKUP_TYPED")
+		if v, present, err := LookupEnvTyped[bool]("TEST_LOOKUP_TYPED"); v || present || err != nil {
+			t.Errorf("absent: got %v, %v, %v; want false, false, nil", v, present, err)
+		}
+
+		t.Setenv("TEST_LOOKUP_TYPED", "true")
+		if v, present, err := LookupEnvTyped[bool]("TEST_LOOKUP_TYPED"); !v || !present || err != nil {
+			t.Errorf("valid: got %v, %v, %v; want true, true, nil", v, present, err)
+		}
+
+		t.Setenv("TEST_LOOKUP_TYPED", "maybe")
+		if v, present, err := LookupEnvTyped[bool]("TEST_LOOKUP_TYPED"); v || !present || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("invalid: got %v, %v, %v; want false, true, strconv.ErrSyntax", v, present, err)
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		os.Unsetenv("TEST_LOOKUP_TYPED")
+		if v, present, err := LookupEnvTyped[string]("TEST_LOOKUP_TYPED"); v != "" || present || err != nil {
+			t.Errorf("absent: got %q, %v, %v; want \"\", false, nil", v, present, err)
+		}
+
+		t.Setenv("TEST_LOOKUP_TYPED", "")
+		if v, present, err := LookupEnvTyped[string]("TEST_LOOKUP_TYPED"); v != "" || !present || err != nil {
+			t.Errorf("empty: got %q, %v, %v; want \"\", true, nil", v, present, err)
+		}
+
+		t.Setenv("TEST_LOOKUP_TYPED", "value")
+		if v, present, err := LookupEnvTyped[string]("TEST_LOOKUP_TYPED"); v != "value" || !present || err != nil {
+			t.Errorf("valid: got %q, %v, %v; want %q, true, nil", v, present, err, "value")
+		}
+	})
+}
+
+func TestParse(t *testing.T) {
+	// parse calls Parse with def as the default and therefore its type.
+	parse := func(raw string, def interface{}) (interface{}, error) {
+		switch def := def.(type) {
+		case int:
+			return Parse(raw, def)
+		case int8:
+			return Parse(raw, def)
+		case int16:
+			return Parse(raw, def)
+		case int32:
+			return Parse(raw, def)
+		case int64:
+			return Parse(raw, def)
+		case uint:
+			return Parse(raw, def)
+		case uint8:
+			return Parse(raw, def)
+		case uint16:
+			return Parse(raw, def)
+		case uint32:
+			return Parse(raw, def)
+		case uint64:
+			return Parse(raw, def)
+		case float32:
+			return Parse(raw, def)
+		case float64:
+			return Parse(raw, def)
+		case complex128:
+			return Parse(raw, def)
+		case bool:
+			return Parse(raw, def)
+		case string:
+			return Parse(raw, def)
+		case time.Duration:
+			return Parse(raw, def)
+		}
+		panic(fmt.Sprintf("unexpected type %T", def))
+	}
+
+	tests := []struct {
+		name         string
+		raw          string
+		defaultValue interface{}
+		expectedVal  interface{}
+		expectedErr  error
+	}{
+		{name: "Int", raw: "42", defaultValue: 1, expectedVal: 42},
+		{name: "Int_Empty", raw: "", defaultValue: 1, expectedVal: 1},
+		{name: "Int_Invalid", raw: "abc", defaultValue: 1, expectedVal: 1, expectedErr: strconv.ErrSyntax},
+		{name: "Int8", raw: "-8", defaultValue: int8(1), expectedVal: int8(-8)},
+		{name: "Int8_Overflow", raw: "200", defaultValue: int8(1), expectedVal: int8(1), expectedErr: strconv.ErrRange},
+		{name: "Int16", raw: "1600", defaultValue: int16(1), expectedVal: int16(1600)},
+		{name: "Int32", raw: "-32", defaultValue: int32(1), expectedVal: int32(-32)},
+		{name: "Int64", raw: "6400000000", defaultValue: int64(1), expectedVal: int64(6400000000)},
+		{name: "Uint", raw: "7", defaultValue: uint(1), expectedVal: uint(7)},
+		{name: "Uint8", raw: "8", defaultValue: uint8(1), expectedVal: uint8(8)},
+		{name: "Uint16", raw: "16", defaultValue: uint16(1), expectedVal: uint16(16)},
+		{name: "Uint32", raw: "32", defaultValue: uint32(1), expectedVal: uint32(32)},
+		{name: "Uint64", raw: "64", defaultValue: uint64(1), expectedVal: uint64(64)},
+		{name: "Uint64_Negative", raw: "-64", defaultValue: uint64(1), expectedVal: uint64(1), expectedErr: strconv.ErrSyntax},
+		{name: "Float32", raw: "1.5", defaultValue: float32(1), expectedVal: float32(1.5)},
+		{name: "Float64", raw: "2.25", defaultValue: 1.0, expectedVal: 2.25},
+		{name: "Float64_Empty", raw: "", defaultValue: 1.0, expectedVal: 1.0},
+		{name: "Complex128", raw: "2+3i", defaultValue: complex(1, 0), expectedVal: complex(2, 3)},
+		{name: "Bool", raw: "true", defaultValue: false, expectedVal: true},
+		{name: "Bool_Invalid", raw: "yes", defaultValue: false, expectedVal: false, expectedErr: strconv.ErrSyntax},
+		{name: "String", raw: "hello", defaultValue: "def", expectedVal: "hello"},
+		{name: "String_Empty", raw: "", defaultValue: "def", expectedVal: ""},
+		{name: "Duration", raw: "2h", defaultValue: time.Second, expectedVal: 2 * time.Hour},
+		{name: "Duration_Empty", raw: "", defaultValue: time.Second, expectedVal: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualVal, actualErr := parse(tt.raw, tt.defaultValue)
+			if !reflect.DeepEqual(actualVal, tt.expectedVal) {
+				t.Errorf("Parse(%q, %v) returned value %v; want %v", tt.raw, tt.defaultValue, actualVal, tt.expectedVal)
+			}
+			if !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("Parse(%q, %v) returned error %v; want %v", tt.raw, tt.defaultValue, actualErr, tt.expectedErr)
+			}
+			var convErr *ConversionError
+			if tt.expectedErr != nil && (!errors.As(actualErr, &convErr) || convErr.Key != "") {
+				t.Errorf("Parse(%q, %v) returned error %#v; want *ConversionError with no Key", tt.raw, tt.defaultValue, actualErr)
+			}
+		})
+	}
+
+	t.Run("MatchesReadEnv", func(t *testing.T) {
+		t.Setenv("TEST_PARSE", "17")
+		got, err := Parse("17", 0)
+		want, wantErr := ReadEnv("TEST_PARSE", 0)
+		if got != want || err != wantErr {
+			t.Errorf("Parse(%q, 0) = %v, %v; want %v, %v", "17", got, err, want, wantErr)
+		}
+	})
+}
+
+func TestParseValue(t *testing.T) {
+	// parse calls parseValue with the type of want.
+	parse := func(raw string, want interface{}) (interface{}, error) {
+		switch want.(type) {
+		case int:
+			return parseValue[int](raw)
+		case int8:
+			return parseValue[int8](raw)
+		case int16:
+			return parseValue[int16](raw)
+		case int32:
+			return parseValue[int32](raw)
+		case int64:
+			return parseValue[int64](raw)
+		case uint:
+			return parseValue[uint](raw)
+		case uint8:
+			return parseValue[uint8](raw)
+		case uint16:
+			return parseValue[uint16](raw)
+		case uint32:
+			return parseValue[uint32](raw)
+		case uint64:
+			return parseValue[uint64](raw)
+		case float32:
+			return parseValue[float32](raw)
+		case float64:
+			return parseValue[float64](raw)
+		case complex128:
+			return parseValue[complex128](raw)
+		case bool:
+			return parseValue[bool](raw)
+		case string:
+			return parseValue[string](raw)
+		case time.Duration:
+			return parseValue[time.Duration](raw)
+		case []int:
+			return parseValue[[]int](raw)
+		}
+		panic(fmt.Sprintf("unexpected type %T", want))
+	}
+
+	tests := []struct {
+		name        string
+		raw         string
+		expectedVal interface{}
+		expectedErr error // Sentinel expected via errors.Is, nil if no error is expected
+	}{
+		{name: "Int", raw: "-12", expectedVal: -12},
+		{name: "Int_Invalid", raw: "1.5", expectedVal: 0, expectedErr: strconv.ErrSyntax},
+		{name: "Int8", raw: "-128", expectedVal: int8(-128)},
+		{name: "Int8_Range", raw: "128", expectedVal: int8(0), expectedErr: strconv.ErrRange},
+		{name: "Int16", raw: "32767", expectedVal: int16(32767)},
+		{name: "Int32", raw: "-2147483648", expectedVal: int32(-2147483648)},
+		{name: "Int64", raw: "9223372036854775807", expectedVal: int64(9223372036854775807)},
+		{name: "Int64_Range", raw: "9223372036854775808", expectedVal: int64(0), expectedErr: strconv.ErrRange},
+		{name: "Uint", raw: "42", expectedVal: uint(42)},
+		{name: "Uint8", raw: "255", expectedVal: uint8(255)},
+		{name: "Uint16_Range", raw: "65536", expectedVal: uint16(0), expectedErr: strconv.ErrRange},
+		{name: "Uint32", raw: "4294967295", expectedVal: uint32(4294967295)},
+		{name: "Uint64_Negative", raw: "-1", expectedVal: uint64(0), expectedErr: strconv.ErrSyntax},
+		{name: "Float32", raw: "0.5", expectedVal: float32(0.5)},
+		{name: "Float64", raw: "1e-3", expectedVal: 0.001},
+		{name: "Float64_Invalid", raw: "1e", expectedVal: 0.0, expectedErr: strconv.ErrSyntax},
+		{name: "Complex128", raw: "1-2i", expectedVal: complex(1, -2)},
+		{name: "Bool", raw: "T", expectedVal: true},
+		{name: "Bool_Invalid", raw: "yes", expectedVal: false, expectedErr: strconv.ErrSyntax},
+		{name: "String", raw: " raw value ", expectedVal: " raw value "},
+		{name: "String_Empty", raw: "", expectedVal: ""},
+		{name: "Int_Empty", raw: "", expectedVal: 0, expectedErr: strconv.ErrSyntax},
+		{name: "Duration", raw: "1m30s", expectedVal: 90 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualVal, actualErr := parse(tt.raw, tt.expectedVal)
+			if !reflect.DeepEqual(actualVal, tt.expectedVal) {
+				t.Errorf("parseValue[%T](%q) returned value %v; want %v", tt.expectedVal, tt.raw, actualVal, tt.expectedVal)
+			}
+			if !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("parseValue[%T](%q) returned error %v; want %v", tt.expectedVal, tt.raw, actualErr, tt.expectedErr)
+			}
+			var convErr *ConversionError
+			if tt.expectedErr != nil && (!errors.As(actualErr, &convErr) || convErr.Key != "" || convErr.Value != tt.raw) {
+				t.Errorf("parseValue[%T](%q) returned error %#v; want *ConversionError with Value and no Key", tt.expectedVal, tt.raw, actualErr)
+			}
+		})
+	}
+
+	t.Run("Int_PlatformRange", func(t *testing.T) {
+		// 1<<32 fits in a 64-bit int but not in a 32-bit one.
+		const beyond32 = "4294967296"
+		got, err := parseValue[int](beyond32)
+		if strconv.IntSize == 32 {
+			if !errors.Is(err, strconv.ErrRange) {
+				t.Errorf("parseValue[int](%q) returned error %v; want %v", beyond32, err, strconv.ErrRange)
+			}
+		} else if err != nil || int64(got) != 1<<32 {
+			t.Errorf("parseValue[int](%q) = %v, %v; want %v, nil", beyond32, got, err, int64(1)<<32)
+		}
+
+		// Beyond the platform maximum always fails with a range error.
+		tooLarge := strconv.FormatUint(uint64(1)<<(strconv.IntSize-1), 10)
+		if _, err := parseValue[int](tooLarge); !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("parseValue[int](%q) returned error %v; want %v", tooLarge, err, strconv.ErrRange)
+		}
+	})
+
+	t.Run("Duration_Invalid", func(t *testing.T) {
+		if _, err := parseValue[time.Duration]("5"); err == nil {
+			t.Error("parseValue[time.Duration](\"5\") returned nil error; want missing unit error")
+		}
+	})
+
+	t.Run("UnsupportedType", func(t *testing.T) {
+		_, err := parse("1,2", []int(nil))
+		want := `unsupported type for environment variable conversion: []int`
+		if err == nil || err.Error() != want {
+			t.Errorf("parseValue[[]int] returned error %v; want %q", err, want)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package envreader
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrMissingRequired is wrapped by the errors returned when a mandatory
+// variable is unset or empty: by ReadEnvRequired, RequireKeys, Unmarshal for
+// fields tagged ",required", and ReadEnvIndirect for a missing target.
+var ErrMissingRequired = errors.New("required environment variable is not set")
+
+// ConversionError records a failure to convert the value of an environment
+// variable to the requested type.
+type ConversionError struct {
+	Key        string // Name of the environment variable
+	Value      string // Raw value that failed to convert
+	TargetType string // Name of the type conversion was attempted to, e.g. "int"
+	Err        error  // Underlying error, typically a *strconv.NumError
+}
+
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("failed to convert %q to %s: %v", e.Value, e.TargetType, e.Err)
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// maskedValue replaces the raw value of secret variables in errors.
+const maskedValue = "***"
+
+// maskConversionError returns a copy of err with the raw value masked if err
+// is a *ConversionError, and err unchanged otherwise.
+func maskConversionError(err error) error {
+	convErr, ok := err.(*ConversionError)
+	if !ok {
+		return err
+	}
+	masked := *convErr
+	masked.Value = maskedValue
+	masked.Err = maskValue(convErr.Err, convErr.Value)
+	return &masked
+}
+
+// maskValue returns err with every occurrence of raw in its message masked.
+func maskValue(err error, raw string) error {
+	if raw == "" {
+		return err
+	}
+	if numErr, ok := err.(*strconv.NumError); ok {
+		masked := *numErr
+		masked.Num = maskedValue
+		return &masked
+	}
+	return &maskedError{err: err, raw: raw}
+}
+
+// maskedError hides a raw value in the message of the error it wraps.
+type maskedError struct {
+	err error
+	raw string
+}
+
+func (e *maskedError) Error() string {
+	msg := strings.ReplaceAll(e.err.Error(), strconv.Quote(e.raw), strconv.Quote(maskedValue))
+	return strings.ReplaceAll(msg, e.raw, maskedValue)
+}
+
+func (e *maskedError) Unwrap() error {
+	return e.err
+}
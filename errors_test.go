@@ -0,0 +1,133 @@
+package envreader
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConversionError(t *testing.T) {
+	t.Setenv("TEST_CONVERSION_ERROR", "abc")
+
+	_, err := ReadEnv("TEST_CONVERSION_ERROR", 0)
+
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) {
+		t.Fatalf("ReadEnv error = %v (%T); want *ConversionError", err, err)
+	}
+	if convErr.Key != "TEST_CONVERSION_ERROR" {
+		t.Errorf("Key = %q; want %q", convErr.Key, "TEST_CONVERSION_ERROR")
+	}
+	if convErr.Value != "abc" {
+		t.Errorf("Value = %q; want %q", convErr.Value, "abc")
+	}
+	if convErr.TargetType != "int" {
+		t.Errorf("TargetType = %q; want %q", convErr.TargetType, "int")
+	}
+	var numErr *strconv.NumError
+	if !errors.As(convErr.Err, &numErr) {
+		t.Errorf("Err = %v (%T); want *strconv.NumError", convErr.Err, convErr.Err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("errors.Is(%v, strconv.ErrSyntax) = false; want true", err)
+	}
+
+	want := `failed to convert "abc" to int: strconv.ParseInt: parsing "abc": invalid syntax`
+	if err.Error() != want {
+		t.Errorf("Error() = %q; want %q", err, want)
+	}
+}
+
+func TestConversionError_Range(t *testing.T) {
+	t.Setenv("TEST_CONVERSION_ERROR", "300")
+
+	_, err := ReadEnv("TEST_CONVERSION_ERROR", uint8(0))
+
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) {
+		t.Fatalf("ReadEnv error = %v (%T); want *ConversionError", err, err)
+	}
+	if convErr.TargetType != "uint8" || !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("ReadEnv error = %+v; want uint8 target wrapping strconv.ErrRange", convErr)
+	}
+}
+
+func TestReadEnvSecret(t *testing.T) {
+	tests := []struct {
+		name         string
+		envValue     string
+		read         func(key string) error
+		expectedErr  error
+		expectedText string
+	}{
+		{
+			name:     "Int",
+			envValue: "s3cr3t-token",
+			read: func(key string) error {
+				_, err := ReadEnvSecret(key, 0)
+				return err
+			},
+			expectedErr:  strconv.ErrSyntax,
+			expectedText: `failed to convert "***" to int: strconv.ParseInt: parsing "***": invalid syntax`,
+		},
+		{
+			name:     "Duration",
+			envValue: "hunter2",
+			read: func(key string) error {
+				_, err := ReadEnvSecret(key, time.Second)
+				return err
+			},
+			expectedText: `failed to convert "***" to time.Duration: time: invalid duration "***"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_SECRET", tt.envValue)
+
+			err := tt.read("TEST_SECRET")
+			if err == nil {
+				t.Fatal("ReadEnvSecret expected an error, but got nil")
+			}
+			if strings.Contains(err.Error(), tt.envValue) {
+				t.Errorf("ReadEnvSecret error %q leaks the raw value", err)
+			}
+			if err.Error() != tt.expectedText {
+				t.Errorf("ReadEnvSecret error = %q; want %q", err, tt.expectedText)
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("ReadEnvSecret error = %v; want it to wrap %v", err, tt.expectedErr)
+			}
+
+			var convErr *ConversionError
+			if !errors.As(err, &convErr) {
+				t.Fatalf("ReadEnvSecret error = %T; want *ConversionError", err)
+			}
+			if convErr.Key != "TEST_SECRET" || convErr.Value != "***" {
+				t.Errorf("ConversionError = %+v; want Key TEST_SECRET and masked Value", convErr)
+			}
+		})
+	}
+
+	t.Run("HookReceivesMaskedError", func(t *testing.T) {
+		var hookErr error
+		OnError = func(_ string, err error) { hookErr = err }
+		t.Cleanup(func() { OnError = nil })
+		t.Setenv("TEST_SECRET", "s3cr3t-token")
+
+		ReadEnvSecret("TEST_SECRET", 0)
+		if hookErr == nil || strings.Contains(hookErr.Error(), "s3cr3t-token") {
+			t.Errorf("OnError received %v; want a masked error", hookErr)
+		}
+	})
+
+	t.Run("ValidValue", func(t *testing.T) {
+		t.Setenv("TEST_SECRET", "s3cr3t-token")
+		got, err := ReadEnvSecret("TEST_SECRET", "")
+		if err != nil || got != "s3cr3t-token" {
+			t.Errorf("ReadEnvSecret = %q, %v; want the raw value, nil", got, err)
+		}
+	})
+}
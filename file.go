@@ -0,0 +1,26 @@
+package envreader
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ReadEnvFileBacked follows the Docker secrets convention: if key+"_FILE" is
+// set, the file it names is read and its contents, trimmed of surrounding
+// whitespace, are converted like ReadEnv as the value of key. Otherwise key is
+// read directly. A _FILE variable naming a file that cannot be read yields
+// defaultValue and an error.
+func ReadEnvFileBacked[T any](key string, defaultValue T) (T, error) {
+	fileKey := key + "_FILE"
+	path, _ := GetRaw(fileKey)
+	if path == "" {
+		return ReadEnv(key, defaultValue)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to read file named by %q: %w", fileKey, err)
+	}
+	return convert(key, strings.TrimSpace(string(data)), defaultValue)
+}
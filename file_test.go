@@ -0,0 +1,68 @@
+package envreader
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestReadEnvFileBacked(t *testing.T) {
+	t.Run("FileBacked", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "db_password")
+		if err := os.WriteFile(path, []byte("s3cret\n"), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+		t.Setenv("TEST_FILE_BACKED_FILE", path)
+		t.Setenv("TEST_FILE_BACKED", "from-env")
+
+		if got, err := ReadEnvFileBacked("TEST_FILE_BACKED", ""); got != "s3cret" || err != nil {
+			t.Errorf("ReadEnvFileBacked = %q, %v; want %q, nil", got, err, "s3cret")
+		}
+	})
+
+	t.Run("FileBacked_Converted", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "workers")
+		if err := os.WriteFile(path, []byte(" 12 \n"), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+		t.Setenv("TEST_FILE_BACKED_FILE", path)
+
+		if got, err := ReadEnvFileBacked("TEST_FILE_BACKED", 4); got != 12 || err != nil {
+			t.Errorf("ReadEnvFileBacked[int] = %v, %v; want 12, nil", got, err)
+		}
+	})
+
+	t.Run("FileBacked_Invalid", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "workers")
+		if err := os.WriteFile(path, []byte("many"), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+		t.Setenv("TEST_FILE_BACKED_FILE", path)
+
+		if got, err := ReadEnvFileBacked("TEST_FILE_BACKED", 4); got != 4 || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvFileBacked[int] = %v, %v; want 4, strconv.ErrSyntax", got, err)
+		}
+	})
+
+	t.Run("Direct", func(t *testing.T) {
+		os.Unsetenv("TEST_FILE_BACKED_FILE")
+		t.Setenv("TEST_FILE_BACKED", "from-env")
+
+		if got, err := ReadEnvFileBacked("TEST_FILE_BACKED", ""); got != "from-env" || err != nil {
+			t.Errorf("ReadEnvFileBacked = %q, %v; want %q, nil", got, err, "from-env")
+		}
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		t.Setenv("TEST_FILE_BACKED_FILE", filepath.Join(t.TempDir(), "missing"))
+		t.Setenv("TEST_FILE_BACKED", "from-env")
+
+		got, err := ReadEnvFileBacked("TEST_FILE_BACKED", "fallback")
+		if got != "fallback" || !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("ReadEnvFileBacked = %q, %v; want %q, fs.ErrNotExist", got, err, "fallback")
+		}
+	})
+}
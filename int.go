@@ -0,0 +1,141 @@
+package envreader
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ReadEnvInt reads key as an int64 in the given base. A base of 0 detects the
+// base from a 0x, 0o or 0b prefix as strconv.ParseInt does; with base 16 an
+// optional 0x prefix is also accepted. If the variable is unset or empty,
+// defaultValue is returned.
+func ReadEnvInt(key string, defaultValue int64, base int) (int64, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	digits := envValue
+	if base == 16 {
+		digits = trimHexPrefix(digits)
+	}
+	val, err := strconv.ParseInt(digits, base, 64)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to int64 (base %d): %w", envValue, base, err)
+	}
+	return val, nil
+}
+
+// trimHexPrefix removes a 0x or 0X prefix from s, keeping any leading sign.
+func trimHexPrefix(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+	}
+	return sign + s
+}
+
+// ReadEnvIntRange reads key as an int and checks that it lies within the
+// inclusive range [min, max]. Values outside the range yield defaultValue and
+// an error. If the variable is unset or empty, defaultValue is returned.
+func ReadEnvIntRange(key string, defaultValue, min, max int) (int, error) {
+	val, err := ReadEnv(key, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+	if val < min || val > max {
+		return defaultValue, fmt.Errorf("value %d for %q out of range [%d, %d]", val, key, min, max)
+	}
+	return val, nil
+}
+
+// ReadEnvIntUnderscore reads key as an int that may use underscores between
+// digits for readability, as in Go literals: "1_000_000" reads as 1000000.
+// Leading, trailing or doubled underscores are rejected. If the variable is
+// unset or empty, defaultValue is returned.
+func ReadEnvIntUnderscore(key string, defaultValue int) (int, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	digits, ok := stripDigitSeparators(envValue)
+	if !ok {
+		return defaultValue, fmt.Errorf("failed to convert %q to int: underscores must separate digits: %w", envValue, strconv.ErrSyntax)
+	}
+	val, err := strconv.Atoi(digits)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to int: %w", envValue, err)
+	}
+	return val, nil
+}
+
+// stripDigitSeparators removes underscores from s, reporting false if any
+// underscore is not surrounded by digits.
+func stripDigitSeparators(s string) (string, bool) {
+	isDigit := func(i int) bool { return i >= 0 && i < len(s) && s[i] >= '0' && s[i] <= '9' }
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '_' {
+			if !isDigit(i-1) || !isDigit(i+1) {
+				return "", false
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String(), true
+}
+
+// ReadEnvGroupedInt reads key as an int64 that may use commas as thousands
+// separators, so "1,000,000" reads as 1000000. When commas are present, the
+// first group must have one to three digits and every later group exactly
+// three; "1,00,0" is rejected. If the variable is unset or empty,
+// defaultValue is returned.
+func ReadEnvGroupedInt(key string, defaultValue int64) (int64, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	digits, ok := stripGroupSeparators(envValue)
+	if !ok {
+		return defaultValue, fmt.Errorf("failed to convert %q to int64: commas must separate groups of three digits: %w", envValue, strconv.ErrSyntax)
+	}
+	val, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to int64: %w", envValue, err)
+	}
+	return val, nil
+}
+
+// stripGroupSeparators removes thousands-separating commas from s, keeping any
+// leading sign, and reports false if the groups are malformed. The first group
+// of a grouped number may not start with 0, so "0,001" is rejected.
+func stripGroupSeparators(s string) (string, bool) {
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+	groups := strings.Split(s, ",")
+	if len(groups) == 1 {
+		return sign + s, true
+	}
+
+	for i, group := range groups {
+		if (i == 0 && (len(group) < 1 || len(group) > 3 || group[0] == '0')) || (i > 0 && len(group) != 3) {
+			return "", false
+		}
+		for j := 0; j < len(group); j++ {
+			if group[j] < '0' || group[j] > '9' {
+				return "", false
+			}
+		}
+	}
+	return sign + strings.Join(groups, ""), true
+}
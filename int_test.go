@@ -0,0 +1,234 @@
+package envreader
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestReadEnvInt(t *testing.T) {
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		base              int
+		defaultValue      int64
+		expectedVal       int64
+		expectedErr       error
+		expectedErrString string
+	}{
+		{name: "Base10", envValue: "255", setEnv: true, base: 10, expectedVal: 255},
+		{name: "Base16_NoPrefix", envValue: "ff", setEnv: true, base: 16, expectedVal: 255},
+		{name: "Base16_WithPrefix", envValue: "0xFF", setEnv: true, base: 16, expectedVal: 255},
+		{name: "Base16_NegativeWithPrefix", envValue: "-0x10", setEnv: true, base: 16, expectedVal: -16},
+		{name: "Base0_Hex", envValue: "0x1f", setEnv: true, base: 0, expectedVal: 31},
+		{name: "Base0_Octal", envValue: "0o17", setEnv: true, base: 0, expectedVal: 15},
+		{name: "Base0_Binary", envValue: "0b101", setEnv: true, base: 0, expectedVal: 5},
+		{name: "Base0_Decimal", envValue: "42", setEnv: true, base: 0, expectedVal: 42},
+		{name: "EnvNotExists", setEnv: false, base: 10, defaultValue: 7, expectedVal: 7},
+		{
+			name:              "Base16_InvalidDigits",
+			envValue:          "0xZZ",
+			setEnv:            true,
+			base:              16,
+			defaultValue:      7,
+			expectedVal:       7, // Returns default on conversion error
+			expectedErr:       strconv.ErrSyntax,
+			expectedErrString: `failed to convert "0xZZ" to int64 (base 16): strconv.ParseInt: parsing "ZZ": invalid syntax`,
+		},
+		{
+			name:              "Base10_InvalidDigits",
+			envValue:          "12a",
+			setEnv:            true,
+			base:              10,
+			defaultValue:      7,
+			expectedVal:       7, // Returns default on conversion error
+			expectedErr:       strconv.ErrSyntax,
+			expectedErrString: `failed to convert "12a" to int64 (base 10): strconv.ParseInt: parsing "12a": invalid syntax`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_INT_BASE", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_INT_BASE")
+			}
+
+			actualVal, actualErr := ReadEnvInt("TEST_INT_BASE", tt.defaultValue, tt.base)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvInt(%q, %d, %d) returned value %d; want %d", tt.envValue, tt.defaultValue, tt.base, actualVal, tt.expectedVal)
+			}
+			if !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvInt(%q, %d, %d) returned error %v; want %v", tt.envValue, tt.defaultValue, tt.base, actualErr, tt.expectedErr)
+			}
+			if tt.expectedErrString != "" && actualErr != nil && actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvInt(%q, %d, %d) returned error %q; want %q", tt.envValue, tt.defaultValue, tt.base, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvIntRange(t *testing.T) {
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       int
+		expectedErr       error
+		expectedErrString string
+	}{
+		{name: "InRange", envValue: "8", setEnv: true, expectedVal: 8},
+		{name: "OnMinBound", envValue: "1", setEnv: true, expectedVal: 1},
+		{name: "OnMaxBound", envValue: "64", setEnv: true, expectedVal: 64},
+		{name: "EnvNotExists", setEnv: false, expectedVal: 4},
+		{
+			name:              "BelowMin",
+			envValue:          "-3",
+			setEnv:            true,
+			expectedVal:       4,
+			expectedErrString: `value -3 for "TEST_INT_RANGE" out of range [1, 64]`,
+		},
+		{
+			name:              "AboveMax",
+			envValue:          "100000",
+			setEnv:            true,
+			expectedVal:       4,
+			expectedErrString: `value 100000 for "TEST_INT_RANGE" out of range [1, 64]`,
+		},
+		{
+			name:              "Unparseable",
+			envValue:          "many",
+			setEnv:            true,
+			expectedVal:       4,
+			expectedErr:       strconv.ErrSyntax,
+			expectedErrString: `failed to convert "many" to int: strconv.ParseInt: parsing "many": invalid syntax`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_INT_RANGE", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_INT_RANGE")
+			}
+
+			actualVal, actualErr := ReadEnvIntRange("TEST_INT_RANGE", 4, 1, 64)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvIntRange(%q) returned value %d; want %d", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErr != nil && !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvIntRange(%q) returned error %v; want %v", tt.envValue, actualErr, tt.expectedErr)
+			}
+			if tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvIntRange(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+			} else if actualErr == nil || actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvIntRange(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvIntUnderscore(t *testing.T) {
+	tests := []struct {
+		name        string
+		envValue    string
+		setEnv      bool
+		expectedVal int
+		expectedErr error
+	}{
+		{name: "Plain", envValue: "1000", setEnv: true, expectedVal: 1000},
+		{name: "Separated", envValue: "1_000_000", setEnv: true, expectedVal: 1_000_000},
+		{name: "IrregularGroups", envValue: "10_00", setEnv: true, expectedVal: 1000},
+		{name: "Negative", envValue: "-2_500", setEnv: true, expectedVal: -2500},
+		{name: "EnvNotExists", setEnv: false, expectedVal: 9},
+		{name: "Leading", envValue: "_100", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+		{name: "Trailing", envValue: "100_", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+		{name: "Doubled", envValue: "1__000", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+		{name: "AfterSign", envValue: "-_100", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+		{name: "NotANumber", envValue: "1_0x0", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_INT_UNDERSCORE", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_INT_UNDERSCORE")
+			}
+
+			actualVal, actualErr := ReadEnvIntUnderscore("TEST_INT_UNDERSCORE", 9)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvIntUnderscore(%q) returned value %d; want %d", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvIntUnderscore(%q) returned error %v; want %v", tt.envValue, actualErr, tt.expectedErr)
+			}
+		})
+	}
+
+	t.Run("ErrorMessage", func(t *testing.T) {
+		t.Setenv("TEST_INT_UNDERSCORE", "1__000")
+		_, err := ReadEnvIntUnderscore("TEST_INT_UNDERSCORE", 9)
+		want := `failed to convert "1__000" to int: underscores must separate digits: invalid syntax`
+		if err == nil || err.Error() != want {
+			t.Errorf("ReadEnvIntUnderscore error = %v; want %q", err, want)
+		}
+	})
+}
+
+func TestReadEnvGroupedInt(t *testing.T) {
+	tests := []struct {
+		name        string
+		envValue    string
+		setEnv      bool
+		expectedVal int64
+		expectedErr error
+	}{
+		{name: "Grouped", envValue: "1,000,000", setEnv: true, expectedVal: 1_000_000},
+		{name: "ShortFirstGroup", envValue: "12,345", setEnv: true, expectedVal: 12345},
+		{name: "NotGrouped", envValue: "1000000", setEnv: true, expectedVal: 1_000_000},
+		{name: "Negative", envValue: "-2,500", setEnv: true, expectedVal: -2500},
+		{name: "EnvNotExists", setEnv: false, expectedVal: 9},
+		{name: "IrregularGroups", envValue: "1,00,0", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+		{name: "LongFirstGroup", envValue: "1000,000", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+		{name: "LeadingZeroGroup", envValue: "0,001", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+		{name: "LeadingZeroGroup_Negative", envValue: "-0,123", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+		{name: "Leading", envValue: ",100", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+		{name: "Trailing", envValue: "100,", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+		{name: "NotANumber", envValue: "1,0x0", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrSyntax},
+		{name: "Overflow", envValue: "9,223,372,036,854,775,808", setEnv: true, expectedVal: 9, expectedErr: strconv.ErrRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_GROUPED_INT", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_GROUPED_INT")
+			}
+
+			actualVal, actualErr := ReadEnvGroupedInt("TEST_GROUPED_INT", 9)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvGroupedInt(%q) returned value %d; want %d", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvGroupedInt(%q) returned error %v; want %v", tt.envValue, actualErr, tt.expectedErr)
+			}
+		})
+	}
+
+	t.Run("ErrorMessage", func(t *testing.T) {
+		t.Setenv("TEST_GROUPED_INT", "1,00,0")
+		_, err := ReadEnvGroupedInt("TEST_GROUPED_INT", 9)
+		want := `failed to convert "1,00,0" to int64: commas must separate groups of three digits: invalid syntax`
+		if err == nil || err.Error() != want {
+			t.Errorf("ReadEnvGroupedInt error = %v; want %q", err, want)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package envreader
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Loader reads several variables in a row and collects conversion errors
+// instead of stopping at the first one. Each method returns the parsed value,
+// or the default if the variable is unset or invalid, so a config can be built
+// inline and checked once with Err. The zero value is ready to use.
+type Loader struct {
+	errs    []error
+	entries []loadEntry
+}
+
+// loadEntry records the outcome of a single Loader read for DebugString and
+// Values.
+type loadEntry struct {
+	key       string
+	value     any  // Resolved value
+	isDefault bool // The default was used because the key was unset, empty or invalid
+	err       error
+	secret    bool
+}
+
+// Int reads key as an int.
+func (l *Loader) Int(key string, defaultValue int) int {
+	return load(l, key, defaultValue, readOptions{})
+}
+
+// Int64 reads key as an int64.
+func (l *Loader) Int64(key string, defaultValue int64) int64 {
+	return load(l, key, defaultValue, readOptions{})
+}
+
+// String reads key as a string.
+func (l *Loader) String(key string, defaultValue string) string {
+	return load(l, key, defaultValue, readOptions{})
+}
+
+// Bool reads key as a bool.
+func (l *Loader) Bool(key string, defaultValue bool) bool {
+	return load(l, key, defaultValue, readOptions{})
+}
+
+// Float64 reads key as a float64.
+func (l *Loader) Float64(key string, defaultValue float64) float64 {
+	return load(l, key, defaultValue, readOptions{})
+}
+
+// Duration reads key as a time.Duration.
+func (l *Loader) Duration(key string, defaultValue time.Duration) time.Duration {
+	return load(l, key, defaultValue, readOptions{})
+}
+
+// Secret reads key as a string holding a password, token or other secret. Its
+// value is masked in errors and in DebugString.
+func (l *Loader) Secret(key string, defaultValue string) string {
+	return load(l, key, defaultValue, readOptions{secret: true})
+}
+
+// Err returns all errors collected so far joined with errors.Join, or nil if
+// every read succeeded.
+func (l *Loader) Err() error {
+	return errors.Join(l.errs...)
+}
+
+// DebugString returns a summary of every read so far, one line per key in read
+// order, showing the resolved value and whether the default was used or an
+// error occurred. Values of keys read with Secret are shown as "***". It is
+// meant for logging the effective configuration at startup.
+func (l *Loader) DebugString() string {
+	var b strings.Builder
+	for _, e := range l.entries {
+		value := fmt.Sprint(e.value)
+		if e.secret {
+			value = maskedValue
+		}
+		source := "env"
+		if e.isDefault {
+			source = "default"
+		}
+		fmt.Fprintf(&b, "%s=%s (%s", e.key, value, source)
+		if e.err != nil {
+			fmt.Fprintf(&b, ", error: %v", e.err)
+		}
+		b.WriteString(")\n")
+	}
+	return b.String()
+}
+
+// Values returns the resolved value of every key read so far, typed as read
+// and including defaults where they were used. Keys read with Secret map to
+// "***". If a key was read more than once, the last read wins. It is meant for
+// diagnostics such as a debug endpoint.
+func (l *Loader) Values() map[string]any {
+	values := make(map[string]any, len(l.entries))
+	for _, e := range l.entries {
+		if e.secret {
+			values[e.key] = maskedValue
+			continue
+		}
+		values[e.key] = e.value
+	}
+	return values
+}
+
+func load[T any](l *Loader, key string, defaultValue T, opts readOptions) T {
+	raw, ok := GetRaw(key)
+	val, err := readEnv(func(string) (string, bool) { return raw, ok }, key, defaultValue, opts)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Errorf("invalid value for %q: %w", key, err))
+	}
+	l.entries = append(l.entries, loadEntry{
+		key:       key,
+		value:     val,
+		isDefault: !ok || isEmptyValue[T](raw) || err != nil,
+		err:       err,
+		secret:    opts.secret,
+	})
+	return val
+}
@@ -0,0 +1,127 @@
+package envreader
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoader(t *testing.T) {
+	t.Run("AllValid", func(t *testing.T) {
+		t.Setenv("TEST_LOADER_PORT", "8080")
+		t.Setenv("TEST_LOADER_HOST", "localhost")
+		t.Setenv("TEST_LOADER_DEBUG", "true")
+		t.Setenv("TEST_LOADER_TIMEOUT", "5s")
+		os.Unsetenv("TEST_LOADER_RATIO")
+
+		var l Loader
+		port := l.Int("TEST_LOADER_PORT", 80)
+		host := l.String("TEST_LOADER_HOST", "0.0.0.0")
+		debug := l.Bool("TEST_LOADER_DEBUG", false)
+		timeout := l.Duration("TEST_LOADER_TIMEOUT", time.Second)
+		ratio := l.Float64("TEST_LOADER_RATIO", 0.5)
+
+		if err := l.Err(); err != nil {
+			t.Fatalf("Loader.Err() = %v; want nil", err)
+		}
+		if port != 8080 || host != "localhost" || !debug || timeout != 5*time.Second || ratio != 0.5 {
+			t.Errorf("Loader values = %v, %q, %v, %v, %v; want 8080, %q, true, 5s, 0.5", port, host, debug, timeout, ratio, "localhost")
+		}
+	})
+
+	t.Run("MultipleInvalid_JoinedError", func(t *testing.T) {
+		t.Setenv("TEST_LOADER_PORT", "eighty")
+		t.Setenv("TEST_LOADER_DEBUG", "maybe")
+		t.Setenv("TEST_LOADER_RATIO", "half")
+		t.Setenv("TEST_LOADER_HOST", "localhost")
+
+		var l Loader
+		port := l.Int("TEST_LOADER_PORT", 80)
+		debug := l.Bool("TEST_LOADER_DEBUG", true)
+		ratio := l.Float64("TEST_LOADER_RATIO", 0.5)
+		host := l.String("TEST_LOADER_HOST", "0.0.0.0")
+
+		if port != 80 || !debug || ratio != 0.5 || host != "localhost" {
+			t.Errorf("Loader values = %v, %v, %v, %q; want defaults for invalid keys", port, debug, ratio, host)
+		}
+
+		err := l.Err()
+		if err == nil {
+			t.Fatal("Loader.Err() = nil; want joined error")
+		}
+		if !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("Loader.Err() = %v; want it to wrap strconv.ErrSyntax", err)
+		}
+		for _, key := range []string{"TEST_LOADER_PORT", "TEST_LOADER_DEBUG", "TEST_LOADER_RATIO"} {
+			if !strings.Contains(err.Error(), key) {
+				t.Errorf("Loader.Err() = %q; want it to mention %q", err, key)
+			}
+		}
+		if strings.Contains(err.Error(), "TEST_LOADER_HOST") {
+			t.Errorf("Loader.Err() = %q; should not mention valid key %q", err, "TEST_LOADER_HOST")
+		}
+	})
+}
+
+func TestLoaderDebugString(t *testing.T) {
+	t.Setenv("TEST_LOADER_PORT", "8080")
+	t.Setenv("TEST_LOADER_DEBUG", "maybe")
+	t.Setenv("TEST_LOADER_PASSWORD", "hunter2")
+	os.Unsetenv("TEST_LOADER_TIMEOUT")
+
+	var l Loader
+	l.Int("TEST_LOADER_PORT", 80)
+	l.Bool("TEST_LOADER_DEBUG", true)
+	l.Duration("TEST_LOADER_TIMEOUT", 5*time.Second)
+	l.Secret("TEST_LOADER_PASSWORD", "")
+
+	got := l.DebugString()
+	want := strings.Join([]string{
+		"TEST_LOADER_PORT=8080 (env)",
+		`TEST_LOADER_DEBUG=true (default, error: failed to convert "maybe" to bool: strconv.ParseBool: parsing "maybe": invalid syntax)`,
+		"TEST_LOADER_TIMEOUT=5s (default)",
+		"TEST_LOADER_PASSWORD=*** (env)",
+	}, "\n") + "\n"
+	if got != want {
+		t.Errorf("Loader.DebugString() = %q; want %q", got, want)
+	}
+	if strings.Contains(got, "hunter2") {
+		t.Errorf("Loader.DebugString() = %q; should not contain the secret value", got)
+	}
+
+	if got := (&Loader{}).DebugString(); got != "" {
+		t.Errorf("empty Loader.DebugString() = %q; want empty", got)
+	}
+}
+
+func TestLoaderValues(t *testing.T) {
+	t.Setenv("TEST_LOADER_PORT", "8080")
+	t.Setenv("TEST_LOADER_PASSWORD", "hunter2")
+	t.Setenv("TEST_LOADER_RATIO", "half")
+	os.Unsetenv("TEST_LOADER_TIMEOUT")
+
+	var l Loader
+	l.Int("TEST_LOADER_PORT", 80)
+	l.Duration("TEST_LOADER_TIMEOUT", 5*time.Second)
+	l.Float64("TEST_LOADER_RATIO", 0.5)
+	l.Secret("TEST_LOADER_PASSWORD", "")
+
+	got := l.Values()
+	want := map[string]any{
+		"TEST_LOADER_PORT":     8080,
+		"TEST_LOADER_TIMEOUT":  5 * time.Second,
+		"TEST_LOADER_RATIO":    0.5,
+		"TEST_LOADER_PASSWORD": "***",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Loader.Values() = %v; want %v", got, want)
+	}
+
+	if got := (&Loader{}).Values(); len(got) != 0 {
+		t.Errorf("empty Loader.Values() = %v; want empty map", got)
+	}
+}
@@ -0,0 +1,68 @@
+package envreader
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// ReadEnvMap reads key as a list of key/value pairs such as
+// "env=prod,team=core", splitting pairs on pairSep and each pair on kvSep.
+// Whitespace around keys and values is trimmed and empty pairs are skipped. If
+// a key repeats, its last value wins. If the variable is unset or empty,
+// defaultValue is returned.
+func ReadEnvMap(key string, defaultValue map[string]string, pairSep, kvSep string) (map[string]string, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	result, err := parseStringMap(envValue, pairSep, kvSep)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to map[string]string: %w", envValue, err)
+	}
+	return result, nil
+}
+
+// ReadEnvMapTyped is like ReadEnvMap but converts each value to V with the
+// same rules as ReadEnv, so "a=10,b=20" can be read as a map[string]int. If a
+// value fails to convert, defaultValue is returned with an error naming its
+// map key.
+func ReadEnvMapTyped[V any](key string, defaultValue map[string]V, pairSep, kvSep string) (map[string]V, error) {
+	raw, err := ReadEnvMap(key, nil, pairSep, kvSep)
+	if err != nil {
+		return defaultValue, err
+	}
+	if raw == nil {
+		return defaultValue, nil
+	}
+
+	result := make(map[string]V, len(raw))
+	for _, k := range slices.Sorted(maps.Keys(raw)) {
+		val, err := parseValue[V](raw[k])
+		if err != nil {
+			return defaultValue, fmt.Errorf("failed to convert value of %q in %q: %w", k, key, err)
+		}
+		result[k] = val
+	}
+	return result, nil
+}
+
+// parseStringMap splits s into pairs on pairSep and each pair into a key and
+// value on kvSep.
+func parseStringMap(s, pairSep, kvSep string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, pair := range splitList(s, pairSep) {
+		k, v, found := strings.Cut(pair, kvSep)
+		if !found {
+			return nil, fmt.Errorf("malformed pair %q: missing %q", pair, kvSep)
+		}
+		k = strings.TrimSpace(k)
+		if k == "" {
+			return nil, fmt.Errorf("malformed pair %q: empty key", pair)
+		}
+		result[k] = strings.TrimSpace(v)
+	}
+	return result, nil
+}
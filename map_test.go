@@ -0,0 +1,159 @@
+package envreader
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestReadEnvMap(t *testing.T) {
+	def := map[string]string{"default": "yes"}
+
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		pairSep           string
+		kvSep             string
+		expectedVal       map[string]string
+		expectedErrString string
+	}{
+		{
+			name:        "CommaEquals",
+			envValue:    "env=prod,team=core",
+			setEnv:      true,
+			pairSep:     ",",
+			kvSep:       "=",
+			expectedVal: map[string]string{"env": "prod", "team": "core"},
+		},
+		{
+			name:        "WhitespaceTolerance",
+			envValue:    " env = prod ,  team=core , ",
+			setEnv:      true,
+			pairSep:     ",",
+			kvSep:       "=",
+			expectedVal: map[string]string{"env": "prod", "team": "core"},
+		},
+		{
+			name:        "SemicolonColon",
+			envValue:    "a:1;b:2",
+			setEnv:      true,
+			pairSep:     ";",
+			kvSep:       ":",
+			expectedVal: map[string]string{"a": "1", "b": "2"},
+		},
+		{
+			name:        "DuplicateKey_LastWins",
+			envValue:    "env=dev,env=prod",
+			setEnv:      true,
+			pairSep:     ",",
+			kvSep:       "=",
+			expectedVal: map[string]string{"env": "prod"},
+		},
+		{
+			name:        "ValueContainsSeparator",
+			envValue:    "query=a=b",
+			setEnv:      true,
+			pairSep:     ",",
+			kvSep:       "=",
+			expectedVal: map[string]string{"query": "a=b"},
+		},
+		{
+			name:        "EnvNotExists",
+			setEnv:      false,
+			pairSep:     ",",
+			kvSep:       "=",
+			expectedVal: def,
+		},
+		{
+			name:              "MalformedPair",
+			envValue:          "env=prod,team",
+			setEnv:            true,
+			pairSep:           ",",
+			kvSep:             "=",
+			expectedVal:       def,
+			expectedErrString: `failed to convert "env=prod,team" to map[string]string: malformed pair "team": missing "="`,
+		},
+		{
+			name:              "EmptyKey",
+			envValue:          "=prod",
+			setEnv:            true,
+			pairSep:           ",",
+			kvSep:             "=",
+			expectedVal:       def,
+			expectedErrString: `failed to convert "=prod" to map[string]string: malformed pair "=prod": empty key`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_MAP", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_MAP")
+			}
+
+			actualVal, actualErr := ReadEnvMap("TEST_MAP", def, tt.pairSep, tt.kvSep)
+			if !reflect.DeepEqual(actualVal, tt.expectedVal) {
+				t.Errorf("ReadEnvMap(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvMap(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+			} else if actualErr == nil || actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvMap(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvMapTyped(t *testing.T) {
+	t.Run("Ints", func(t *testing.T) {
+		t.Setenv("TEST_MAP_TYPED", "a=10, b=20")
+		got, err := ReadEnvMapTyped[int]("TEST_MAP_TYPED", nil, ",", "=")
+		want := map[string]int{"a": 10, "b": 20}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadEnvMapTyped[int] = %v, %v; want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("Bools", func(t *testing.T) {
+		t.Setenv("TEST_MAP_TYPED", "cache:true;metrics:false")
+		got, err := ReadEnvMapTyped[bool]("TEST_MAP_TYPED", nil, ";", ":")
+		want := map[string]bool{"cache": true, "metrics": false}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadEnvMapTyped[bool] = %v, %v; want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("BadValue", func(t *testing.T) {
+		t.Setenv("TEST_MAP_TYPED", "a=10,b=many")
+		def := map[string]int{"default": 1}
+		got, err := ReadEnvMapTyped("TEST_MAP_TYPED", def, ",", "=")
+		if !reflect.DeepEqual(got, def) {
+			t.Errorf("ReadEnvMapTyped[int] returned value %v; want %v", got, def)
+		}
+		want := `failed to convert value of "b" in "TEST_MAP_TYPED": failed to convert "many" to int: strconv.ParseInt: parsing "many": invalid syntax`
+		if !errors.Is(err, strconv.ErrSyntax) || err.Error() != want {
+			t.Errorf("ReadEnvMapTyped[int] returned error %v; want %q", err, want)
+		}
+	})
+
+	t.Run("MalformedPair", func(t *testing.T) {
+		t.Setenv("TEST_MAP_TYPED", "a=10,b")
+		if _, err := ReadEnvMapTyped[int]("TEST_MAP_TYPED", nil, ",", "="); err == nil {
+			t.Error("ReadEnvMapTyped[int] returned nil error; want malformed pair error")
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_MAP_TYPED")
+		def := map[string]int{"default": 1}
+		if got, err := ReadEnvMapTyped("TEST_MAP_TYPED", def, ",", "="); err != nil || !reflect.DeepEqual(got, def) {
+			t.Errorf("ReadEnvMapTyped[int] = %v, %v; want %v, nil", got, err, def)
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package envreader
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+)
+
+// ReadEnvIP reads key as an IPv4 or IPv6 address. If the variable is unset or
+// empty, defaultValue is returned.
+func ReadEnvIP(key string, defaultValue net.IP) (net.IP, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	ip := net.ParseIP(envValue)
+	if ip == nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to net.IP: invalid IP address", envValue)
+	}
+	return ip, nil
+}
+
+// ReadEnvCIDR reads key as a CIDR range such as "192.168.0.0/24" and returns
+// its network, so "192.168.0.7/24" yields 192.168.0.0/24. If the variable is
+// unset or empty, defaultValue is returned.
+func ReadEnvCIDR(key string, defaultValue *net.IPNet) (*net.IPNet, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	_, network, err := net.ParseCIDR(envValue)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to *net.IPNet: %w", envValue, err)
+	}
+	return network, nil
+}
+
+// ReadEnvURL reads key as an absolute URL. Because url.Parse accepts almost
+// anything, a value without a scheme such as "example.com/path" is rejected.
+// If allowedSchemes is non-empty the scheme must also be one of them. If the
+// variable is unset or empty, defaultValue is returned.
+func ReadEnvURL(key string, defaultValue *url.URL, allowedSchemes ...string) (*url.URL, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	u, err := parseURL(envValue, allowedSchemes)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to *url.URL: %w", envValue, err)
+	}
+	return u, nil
+}
+
+// parseURL parses s and checks that it has a scheme, which must be one of
+// allowedSchemes if any are given.
+func parseURL(s string, allowedSchemes []string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" {
+		return nil, errors.New("missing scheme")
+	}
+	if len(allowedSchemes) > 0 && !slices.Contains(allowedSchemes, u.Scheme) {
+		return nil, fmt.Errorf("scheme %q not in allowed set %v", u.Scheme, allowedSchemes)
+	}
+	return u, nil
+}
+
+// ReadEnvPort reads key as a TCP or UDP port number in the range 1 to 65535.
+// Values outside the range yield defaultValue and an error. If the variable is
+// unset or empty, defaultValue is returned.
+func ReadEnvPort(key string, defaultValue int) (int, error) {
+	return ReadEnvIntRange(key, defaultValue, 1, 65535)
+}
+
+// ReadEnvHostPorts reads key as a list of host:port pairs separated by sep,
+// such as "10.0.0.1:9092,10.0.0.2:9092". Each element is trimmed and
+// validated with net.SplitHostPort, so IPv6 hosts must be bracketed as in
+// "[::1]:80". An element without a port yields defaultValue and an error
+// naming it. If the variable is unset or empty, defaultValue is returned.
+func ReadEnvHostPorts(key string, defaultValue []string, sep string) ([]string, error) {
+	return readSlice(key, defaultValue, sep, func(s string) (string, error) {
+		_, port, err := net.SplitHostPort(s)
+		if err != nil {
+			return "", err
+		}
+		if port == "" {
+			return "", errors.New("missing port in address")
+		}
+		return s, nil
+	})
+}
+
+// ReadEnvURLSlice reads key as a list of absolute URLs separated by sep. Each
+// element is trimmed and must have a scheme, as with ReadEnvURL. An invalid
+// element yields defaultValue and an error naming it. If the variable is unset
+// or empty, defaultValue is returned.
+func ReadEnvURLSlice(key string, defaultValue []*url.URL, sep string) ([]*url.URL, error) {
+	return readSlice(key, defaultValue, sep, func(s string) (*url.URL, error) {
+		return parseURL(s, nil)
+	})
+}
@@ -0,0 +1,336 @@
+package envreader
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestReadEnvIP(t *testing.T) {
+	def := net.ParseIP("127.0.0.1")
+
+	tests := []struct {
+		name        string
+		envValue    string
+		setEnv      bool
+		expectedVal net.IP
+		expectErr   bool
+	}{
+		{name: "IPv4", envValue: "10.0.0.1", setEnv: true, expectedVal: net.ParseIP("10.0.0.1")},
+		{name: "IPv6", envValue: "2001:db8::1", setEnv: true, expectedVal: net.ParseIP("2001:db8::1")},
+		{name: "EnvNotExists", setEnv: false, expectedVal: def},
+		{name: "Malformed", envValue: "10.0.0.256", setEnv: true, expectedVal: def, expectErr: true},
+		{name: "Hostname", envValue: "localhost", setEnv: true, expectedVal: def, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_IP", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_IP")
+			}
+
+			actualVal, actualErr := ReadEnvIP("TEST_IP", def)
+			if !actualVal.Equal(tt.expectedVal) {
+				t.Errorf("ReadEnvIP(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if (actualErr != nil) != tt.expectErr {
+				t.Errorf("ReadEnvIP(%q) returned error %v; want error: %v", tt.envValue, actualErr, tt.expectErr)
+			}
+		})
+	}
+}
+
+func TestReadEnvCIDR(t *testing.T) {
+	_, def, _ := net.ParseCIDR("127.0.0.0/8")
+
+	tests := []struct {
+		name        string
+		envValue    string
+		setEnv      bool
+		expectedVal string
+		expectErr   bool
+	}{
+		{name: "IPv4", envValue: "192.168.0.0/24", setEnv: true, expectedVal: "192.168.0.0/24"},
+		{name: "IPv4_HostBitsMasked", envValue: "192.168.0.7/24", setEnv: true, expectedVal: "192.168.0.0/24"},
+		{name: "IPv6", envValue: "2001:db8::1/32", setEnv: true, expectedVal: "2001:db8::/32"},
+		{name: "EnvNotExists", setEnv: false, expectedVal: "127.0.0.0/8"},
+		{name: "MissingMask", envValue: "192.168.0.0", setEnv: true, expectedVal: "127.0.0.0/8", expectErr: true},
+		{name: "Malformed", envValue: "192.168.0.0/33", setEnv: true, expectedVal: "127.0.0.0/8", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_CIDR", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_CIDR")
+			}
+
+			actualVal, actualErr := ReadEnvCIDR("TEST_CIDR", def)
+			if actualVal.String() != tt.expectedVal {
+				t.Errorf("ReadEnvCIDR(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if (actualErr != nil) != tt.expectErr {
+				t.Errorf("ReadEnvCIDR(%q) returned error %v; want error: %v", tt.envValue, actualErr, tt.expectErr)
+			}
+			var parseErr *net.ParseError
+			if tt.expectErr && !errors.As(actualErr, &parseErr) {
+				t.Errorf("ReadEnvCIDR(%q) returned error %v; want *net.ParseError", tt.envValue, actualErr)
+			}
+		})
+	}
+}
+
+func TestReadEnvURL(t *testing.T) {
+	def, _ := url.Parse("http://localhost:8080")
+
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		allowedSchemes    []string
+		expectedVal       string
+		expectedErrString string
+	}{
+		{name: "FullHTTPS", envValue: "https://api.example.com/v1?x=1", setEnv: true, expectedVal: "https://api.example.com/v1?x=1"},
+		{name: "AllowedScheme", envValue: "https://example.com", setEnv: true, allowedSchemes: []string{"http", "https"}, expectedVal: "https://example.com"},
+		{name: "EnvNotExists", setEnv: false, expectedVal: "http://localhost:8080"},
+		{
+			name:              "MissingScheme",
+			envValue:          "example.com/path",
+			setEnv:            true,
+			expectedVal:       "http://localhost:8080",
+			expectedErrString: `failed to convert "example.com/path" to *url.URL: missing scheme`,
+		},
+		{
+			name:              "SchemeRelative",
+			envValue:          "//example.com/path",
+			setEnv:            true,
+			expectedVal:       "http://localhost:8080",
+			expectedErrString: `failed to convert "//example.com/path" to *url.URL: missing scheme`,
+		},
+		{
+			name:              "DisallowedScheme",
+			envValue:          "ftp://example.com",
+			setEnv:            true,
+			allowedSchemes:    []string{"http", "https"},
+			expectedVal:       "http://localhost:8080",
+			expectedErrString: `failed to convert "ftp://example.com" to *url.URL: scheme "ftp" not in allowed set [http https]`,
+		},
+		{
+			name:              "Garbage",
+			envValue:          "http://[::1",
+			setEnv:            true,
+			expectedVal:       "http://localhost:8080",
+			expectedErrString: `failed to convert "http://[::1" to *url.URL: parse "http://[::1": missing ']' in host`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_URL", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_URL")
+			}
+
+			actualVal, actualErr := ReadEnvURL("TEST_URL", def, tt.allowedSchemes...)
+			if actualVal.String() != tt.expectedVal {
+				t.Errorf("ReadEnvURL(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvURL(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+			} else if actualErr == nil || actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvURL(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvPort(t *testing.T) {
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       int
+		expectedErrString string
+	}{
+		{name: "Valid", envValue: "8080", setEnv: true, expectedVal: 8080},
+		{name: "Lowest", envValue: "1", setEnv: true, expectedVal: 1},
+		{name: "Highest", envValue: "65535", setEnv: true, expectedVal: 65535},
+		{name: "EnvNotExists", setEnv: false, expectedVal: 80},
+		{
+			name:              "AboveRange",
+			envValue:          "70000",
+			setEnv:            true,
+			expectedVal:       80,
+			expectedErrString: `value 70000 for "TEST_PORT" out of range [1, 65535]`,
+		},
+		{
+			name:              "BelowRange",
+			envValue:          "-1",
+			setEnv:            true,
+			expectedVal:       80,
+			expectedErrString: `value -1 for "TEST_PORT" out of range [1, 65535]`,
+		},
+		{
+			name:              "Zero",
+			envValue:          "0",
+			setEnv:            true,
+			expectedVal:       80,
+			expectedErrString: `value 0 for "TEST_PORT" out of range [1, 65535]`,
+		},
+		{
+			name:              "NonNumeric",
+			envValue:          "http",
+			setEnv:            true,
+			expectedVal:       80,
+			expectedErrString: `failed to convert "http" to int: strconv.ParseInt: parsing "http": invalid syntax`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_PORT", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_PORT")
+			}
+
+			actualVal, actualErr := ReadEnvPort("TEST_PORT", 80)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvPort(%q) returned value %d; want %d", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvPort(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+			} else if actualErr == nil || actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvPort(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvHostPorts(t *testing.T) {
+	def := []string{"localhost:9092"}
+
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       []string
+		expectedErrString string
+	}{
+		{name: "ValidPairs", envValue: "10.0.0.1:9092, 10.0.0.2:9092", setEnv: true, expectedVal: []string{"10.0.0.1:9092", "10.0.0.2:9092"}},
+		{name: "Hostnames", envValue: "kafka-1:9092,kafka-2:9093,", setEnv: true, expectedVal: []string{"kafka-1:9092", "kafka-2:9093"}},
+		{name: "IPv6", envValue: "[::1]:80,[2001:db8::1]:443", setEnv: true, expectedVal: []string{"[::1]:80", "[2001:db8::1]:443"}},
+		{name: "EnvNotExists", setEnv: false, expectedVal: def},
+		{
+			name:              "MissingPort",
+			envValue:          "10.0.0.1:9092,10.0.0.2",
+			setEnv:            true,
+			expectedVal:       def,
+			expectedErrString: `failed to convert element 1 ("10.0.0.2") of "TEST_HOST_PORTS": address 10.0.0.2: missing port in address`,
+		},
+		{
+			name:              "EmptyPort",
+			envValue:          "10.0.0.1:",
+			setEnv:            true,
+			expectedVal:       def,
+			expectedErrString: `failed to convert element 0 ("10.0.0.1:") of "TEST_HOST_PORTS": missing port in address`,
+		},
+		{
+			name:              "UnbracketedIPv6",
+			envValue:          "::1:80",
+			setEnv:            true,
+			expectedVal:       def,
+			expectedErrString: `failed to convert element 0 ("::1:80") of "TEST_HOST_PORTS": address ::1:80: too many colons in address`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_HOST_PORTS", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_HOST_PORTS")
+			}
+
+			actualVal, actualErr := ReadEnvHostPorts("TEST_HOST_PORTS", def, ",")
+			if !reflect.DeepEqual(actualVal, tt.expectedVal) {
+				t.Errorf("ReadEnvHostPorts(%q) returned value %q; want %q", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvHostPorts(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+			} else if actualErr == nil || actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvHostPorts(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvURLSlice(t *testing.T) {
+	def := []*url.URL{{Scheme: "http", Host: "localhost:8080"}}
+
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       []string
+		expectedErrString string
+	}{
+		{name: "ValidURLs", envValue: "https://a.example.com,http://b.example.com:8080/path", setEnv: true, expectedVal: []string{"https://a.example.com", "http://b.example.com:8080/path"}},
+		{name: "Whitespace", envValue: " https://a.example.com , redis://cache:6379 ,", setEnv: true, expectedVal: []string{"https://a.example.com", "redis://cache:6379"}},
+		{name: "EnvNotExists", setEnv: false, expectedVal: []string{"http://localhost:8080"}},
+		{
+			name:              "MissingScheme",
+			envValue:          "https://a.example.com,b.example.com/path",
+			setEnv:            true,
+			expectedVal:       []string{"http://localhost:8080"},
+			expectedErrString: `failed to convert element 1 ("b.example.com/path") of "TEST_URLS": missing scheme`,
+		},
+		{
+			name:              "InvalidURL",
+			envValue:          "http://[::1",
+			setEnv:            true,
+			expectedVal:       []string{"http://localhost:8080"},
+			expectedErrString: `failed to convert element 0 ("http://[::1") of "TEST_URLS": parse "http://[::1": missing ']' in host`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_URLS", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_URLS")
+			}
+
+			actualVal, actualErr := ReadEnvURLSlice("TEST_URLS", def, ",")
+			actualStrs := make([]string, len(actualVal))
+			for i, u := range actualVal {
+				actualStrs[i] = u.String()
+			}
+			if !reflect.DeepEqual(actualStrs, tt.expectedVal) {
+				t.Errorf("ReadEnvURLSlice(%q) returned value %q; want %q", tt.envValue, actualStrs, tt.expectedVal)
+			}
+			if tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvURLSlice(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+			} else if actualErr == nil || actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvURLSlice(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package envreader
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// Signed is satisfied by the signed integer types and types derived from them.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is satisfied by the unsigned integer types and types derived from
+// them.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Integer is satisfied by every integer type and types derived from them.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is satisfied by the floating-point types and types derived from them.
+type Float interface {
+	~float32 | ~float64
+}
+
+// ReadSignedInt reads key as a signed integer of type T. Values that do not fit
+// in T yield defaultValue and an error wrapping strconv.ErrRange. If the
+// variable is unset or empty, defaultValue is returned.
+func ReadSignedInt[T Signed](key string, defaultValue T) (T, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	val, err := strconv.ParseInt(envValue, 10, bitSize[T]())
+	if err != nil {
+		return defaultValue, &ConversionError{Key: key, Value: envValue, TargetType: fmt.Sprintf("%T", defaultValue), Err: err}
+	}
+	return T(val), nil
+}
+
+// ReadInteger reads key as an integer of any type T, signed or unsigned,
+// including named types such as a port number declared as uint16. The value is
+// parsed as a 64-bit integer and range-checked before narrowing, so values
+// that do not fit in T yield defaultValue and an error wrapping
+// strconv.ErrRange. If the variable is unset or empty, defaultValue is
+// returned.
+func ReadInteger[T Integer](key string, defaultValue T) (T, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	var result T
+	var err error
+	shift := 64 - bitSize[T]()
+	if signed := result-1 < result; signed {
+		var val int64
+		val, err = strconv.ParseInt(envValue, 10, 64)
+		if err == nil && (val < math.MinInt64>>shift || val > math.MaxInt64>>shift) {
+			err = &strconv.NumError{Func: "ParseInt", Num: envValue, Err: strconv.ErrRange}
+		}
+		result = T(val)
+	} else {
+		var val uint64
+		val, err = strconv.ParseUint(envValue, 10, 64)
+		if err == nil && val > math.MaxUint64>>shift {
+			err = &strconv.NumError{Func: "ParseUint", Num: envValue, Err: strconv.ErrRange}
+		}
+		result = T(val)
+	}
+	if err != nil {
+		return defaultValue, &ConversionError{Key: key, Value: envValue, TargetType: fmt.Sprintf("%T", defaultValue), Err: err}
+	}
+	return result, nil
+}
+
+// ReadFloat reads key as a floating-point number of type T. Values that do not
+// fit in T yield defaultValue and an error wrapping strconv.ErrRange. If the
+// variable is unset or empty, defaultValue is returned.
+func ReadFloat[T Float](key string, defaultValue T) (T, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	val, err := strconv.ParseFloat(envValue, bitSize[T]())
+	if err != nil {
+		return defaultValue, &ConversionError{Key: key, Value: envValue, TargetType: fmt.Sprintf("%T", defaultValue), Err: err}
+	}
+	return T(val), nil
+}
+
+// ReadEnvPercent reads key as a fraction. A value with a trailing "%" is
+// divided by 100, so "25%" yields 0.25, while a bare number such as "0.25" is
+// returned as-is. Percentages are not clamped: "150%" yields 1.5 and "-5%"
+// yields -0.05. If the variable is unset or empty, defaultValue is returned.
+func ReadEnvPercent(key string, defaultValue float64) (float64, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	number, isPercent := strings.CutSuffix(strings.TrimSpace(envValue), "%")
+	val, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+	if err != nil {
+		return defaultValue, &ConversionError{Key: key, Value: envValue, TargetType: "percentage", Err: err}
+	}
+	if isPercent {
+		val /= 100
+	}
+	return val, nil
+}
+
+// ReadEnvBigInt reads key as an arbitrary-precision integer. The base is
+// inferred from the prefix as for Go literals, so "0xff" and "0b101" are
+// accepted. If the variable is unset or empty, defaultValue is returned.
+func ReadEnvBigInt(key string, defaultValue *big.Int) (*big.Int, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	val, ok := new(big.Int).SetString(envValue, 0)
+	if !ok {
+		return defaultValue, &ConversionError{Key: key, Value: envValue, TargetType: "*big.Int", Err: strconv.ErrSyntax}
+	}
+	return val, nil
+}
+
+// ReadEnvBigFloat reads key as an arbitrary-precision floating-point number.
+// If the variable is unset or empty, defaultValue is returned.
+func ReadEnvBigFloat(key string, defaultValue *big.Float) (*big.Float, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	val, ok := new(big.Float).SetString(envValue)
+	if !ok {
+		return defaultValue, &ConversionError{Key: key, Value: envValue, TargetType: "*big.Float", Err: strconv.ErrSyntax}
+	}
+	return val, nil
+}
+
+// bitSize returns the size of T in bits.
+func bitSize[T any]() int {
+	var zero T
+	return int(unsafe.Sizeof(zero)) * 8
+}
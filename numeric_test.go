@@ -0,0 +1,218 @@
+package envreader
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"strconv"
+	"testing"
+)
+
+type celsius float32
+
+type retries int8
+
+type port uint16
+
+// checkNumeric calls read and compares the result with the expected value and
+// error.
+func checkNumeric[T comparable](t *testing.T, read func(string, T) (T, error), envValue string, def, want T, wantErr error) {
+	t.Helper()
+	t.Setenv("TEST_NUMERIC", envValue)
+
+	got, err := read("TEST_NUMERIC", def)
+	if got != want {
+		t.Errorf("read(%q) returned value %v; want %v", envValue, got, want)
+	}
+	if !errors.Is(err, wantErr) {
+		t.Errorf("read(%q) returned error %v; want %v", envValue, err, wantErr)
+	}
+}
+
+func TestReadSignedInt(t *testing.T) {
+	t.Run("Int", func(t *testing.T) { checkNumeric(t, ReadSignedInt[int], "-42", 0, -42, nil) })
+	t.Run("Int8", func(t *testing.T) { checkNumeric(t, ReadSignedInt[int8], "127", 0, 127, nil) })
+	t.Run("Int8_Overflow", func(t *testing.T) { checkNumeric(t, ReadSignedInt[int8], "128", 1, 1, strconv.ErrRange) })
+	t.Run("Int16", func(t *testing.T) { checkNumeric(t, ReadSignedInt[int16], "-32768", 0, -32768, nil) })
+	t.Run("Int16_Overflow", func(t *testing.T) { checkNumeric(t, ReadSignedInt[int16], "32768", 1, 1, strconv.ErrRange) })
+	t.Run("Int32", func(t *testing.T) { checkNumeric(t, ReadSignedInt[int32], "2147483647", 0, 2147483647, nil) })
+	t.Run("Int32_Overflow", func(t *testing.T) { checkNumeric(t, ReadSignedInt[int32], "2147483648", 1, 1, strconv.ErrRange) })
+	t.Run("Int64", func(t *testing.T) {
+		checkNumeric(t, ReadSignedInt[int64], "9223372036854775807", 0, 9223372036854775807, nil)
+	})
+	t.Run("Int64_Invalid", func(t *testing.T) { checkNumeric(t, ReadSignedInt[int64], "abc", 1, 1, strconv.ErrSyntax) })
+	t.Run("NamedType", func(t *testing.T) { checkNumeric(t, ReadSignedInt[retries], "3", 0, 3, nil) })
+	t.Run("NamedType_Overflow", func(t *testing.T) { checkNumeric(t, ReadSignedInt[retries], "300", 5, 5, strconv.ErrRange) })
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_NUMERIC")
+		if got, err := ReadSignedInt("TEST_NUMERIC", int16(9)); got != 9 || err != nil {
+			t.Errorf("ReadSignedInt = %v, %v; want 9, nil", got, err)
+		}
+	})
+
+	t.Run("ConversionError", func(t *testing.T) {
+		t.Setenv("TEST_NUMERIC", "300")
+		_, err := ReadSignedInt("TEST_NUMERIC", retries(0))
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) || convErr.TargetType != "envreader.retries" {
+			t.Errorf("ReadSignedInt error = %#v; want *ConversionError for envreader.retries", err)
+		}
+	})
+}
+
+func TestReadInteger(t *testing.T) {
+	t.Run("Int", func(t *testing.T) { checkNumeric(t, ReadInteger[int], "-42", 0, -42, nil) })
+	t.Run("Int8_Min", func(t *testing.T) { checkNumeric(t, ReadInteger[int8], "-128", 0, -128, nil) })
+	t.Run("Int8_Overflow", func(t *testing.T) { checkNumeric(t, ReadInteger[int8], "128", 1, 1, strconv.ErrRange) })
+	t.Run("Int8_Underflow", func(t *testing.T) { checkNumeric(t, ReadInteger[int8], "-129", 1, 1, strconv.ErrRange) })
+	t.Run("Int32_Overflow", func(t *testing.T) { checkNumeric(t, ReadInteger[int32], "2147483648", 1, 1, strconv.ErrRange) })
+	t.Run("Int64_Max", func(t *testing.T) {
+		checkNumeric(t, ReadInteger[int64], "9223372036854775807", 0, 9223372036854775807, nil)
+	})
+	t.Run("Int64_Overflow", func(t *testing.T) {
+		checkNumeric(t, ReadInteger[int64], "9223372036854775808", 1, 1, strconv.ErrRange)
+	})
+	t.Run("Uint8", func(t *testing.T) { checkNumeric(t, ReadInteger[uint8], "255", 0, 255, nil) })
+	t.Run("Uint8_Overflow", func(t *testing.T) { checkNumeric(t, ReadInteger[uint8], "256", 1, 1, strconv.ErrRange) })
+	t.Run("Uint_Negative", func(t *testing.T) { checkNumeric(t, ReadInteger[uint], "-1", 1, 1, strconv.ErrSyntax) })
+	t.Run("Uint64_Max", func(t *testing.T) {
+		checkNumeric(t, ReadInteger[uint64], "18446744073709551615", 0, 18446744073709551615, nil)
+	})
+	t.Run("NamedSigned", func(t *testing.T) { checkNumeric(t, ReadInteger[retries], "3", 0, 3, nil) })
+	t.Run("NamedUnsigned", func(t *testing.T) { checkNumeric(t, ReadInteger[port], "8080", 80, 8080, nil) })
+	t.Run("NamedUnsigned_Overflow", func(t *testing.T) { checkNumeric(t, ReadInteger[port], "65536", 80, 80, strconv.ErrRange) })
+	t.Run("Invalid", func(t *testing.T) { checkNumeric(t, ReadInteger[int], "ten", 1, 1, strconv.ErrSyntax) })
+
+	t.Run("OverflowError", func(t *testing.T) {
+		t.Setenv("TEST_NUMERIC", "65536")
+		_, err := ReadInteger("TEST_NUMERIC", port(80))
+		want := `failed to convert "65536" to envreader.port: strconv.ParseUint: parsing "65536": value out of range`
+		if err == nil || err.Error() != want {
+			t.Errorf("ReadInteger[port] returned error %v; want %q", err, want)
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_NUMERIC")
+		if got, err := ReadInteger("TEST_NUMERIC", port(80)); got != 80 || err != nil {
+			t.Errorf("ReadInteger = %v, %v; want 80, nil", got, err)
+		}
+	})
+}
+
+func TestReadFloat(t *testing.T) {
+	t.Run("Float32", func(t *testing.T) { checkNumeric(t, ReadFloat[float32], "3.14", 0, 3.14, nil) })
+	t.Run("Float32_Overflow", func(t *testing.T) { checkNumeric(t, ReadFloat[float32], "1e39", 1, 1, strconv.ErrRange) })
+	t.Run("Float64", func(t *testing.T) { checkNumeric(t, ReadFloat[float64], "1e39", 0, 1e39, nil) })
+	t.Run("Float64_Invalid", func(t *testing.T) { checkNumeric(t, ReadFloat[float64], "pi", 1, 1, strconv.ErrSyntax) })
+	t.Run("NamedType", func(t *testing.T) { checkNumeric(t, ReadFloat[celsius], "-40.5", 0, -40.5, nil) })
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_NUMERIC")
+		if got, err := ReadFloat("TEST_NUMERIC", 2.5); got != 2.5 || err != nil {
+			t.Errorf("ReadFloat = %v, %v; want 2.5, nil", got, err)
+		}
+	})
+}
+
+func TestReadEnvPercent(t *testing.T) {
+	tests := []struct {
+		name        string
+		envValue    string
+		setEnv      bool
+		expectedVal float64
+		expectedErr error
+	}{
+		{name: "Percent", envValue: "25%", setEnv: true, expectedVal: 0.25},
+		{name: "Fraction", envValue: "0.25", setEnv: true, expectedVal: 0.25},
+		{name: "Hundred", envValue: "100%", setEnv: true, expectedVal: 1},
+		{name: "SpaceBeforeSign", envValue: "50 %", setEnv: true, expectedVal: 0.5},
+		{name: "AboveHundred_NotClamped", envValue: "150%", setEnv: true, expectedVal: 1.5},
+		{name: "EnvNotExists", setEnv: false, expectedVal: 0.1},
+		{name: "Invalid", envValue: "half%", setEnv: true, expectedVal: 0.1, expectedErr: strconv.ErrSyntax},
+		{name: "OnlySign", envValue: "%", setEnv: true, expectedVal: 0.1, expectedErr: strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_PERCENT", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_PERCENT")
+			}
+
+			actualVal, actualErr := ReadEnvPercent("TEST_PERCENT", 0.1)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvPercent(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvPercent(%q) returned error %v; want %v", tt.envValue, actualErr, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestReadEnvBigInt(t *testing.T) {
+	def := big.NewInt(1)
+
+	tests := []struct {
+		name        string
+		envValue    string
+		setEnv      bool
+		expectedVal string
+		expectedErr error
+	}{
+		{name: "BeyondInt64", envValue: "123456789012345678901234567890", setEnv: true, expectedVal: "123456789012345678901234567890"},
+		{name: "Negative", envValue: "-98765432109876543210", setEnv: true, expectedVal: "-98765432109876543210"},
+		{name: "Hex", envValue: "0xffffffffffffffffff", setEnv: true, expectedVal: "4722366482869645213695"},
+		{name: "EnvNotExists", setEnv: false, expectedVal: "1"},
+		{name: "Malformed", envValue: "12ab", setEnv: true, expectedVal: "1", expectedErr: strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_BIG_INT", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_BIG_INT")
+			}
+
+			actualVal, actualErr := ReadEnvBigInt("TEST_BIG_INT", def)
+			if actualVal.String() != tt.expectedVal {
+				t.Errorf("ReadEnvBigInt(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvBigInt(%q) returned error %v; want %v", tt.envValue, actualErr, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestReadEnvBigFloat(t *testing.T) {
+	def := big.NewFloat(1)
+
+	t.Run("BeyondFloat64", func(t *testing.T) {
+		t.Setenv("TEST_BIG_FLOAT", "1e400")
+		got, err := ReadEnvBigFloat("TEST_BIG_FLOAT", def)
+		want, _ := new(big.Float).SetString("1e400")
+		if err != nil || got.Cmp(want) != 0 {
+			t.Errorf("ReadEnvBigFloat = %v, %v; want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		t.Setenv("TEST_BIG_FLOAT", "1.2.3")
+		got, err := ReadEnvBigFloat("TEST_BIG_FLOAT", def)
+		if got != def || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvBigFloat = %v, %v; want default, strconv.ErrSyntax", got, err)
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_BIG_FLOAT")
+		if got, err := ReadEnvBigFloat("TEST_BIG_FLOAT", def); got != def || err != nil {
+			t.Errorf("ReadEnvBigFloat = %v, %v; want default, nil", got, err)
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package envreader
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	parsersMu sync.RWMutex
+	parsers   = make(map[reflect.Type]func(string) (any, error))
+)
+
+// RegisterParser makes ReadEnv and the other generic readers able to convert
+// values to the type of sample using parse, which must return a value of that
+// exact type. Types handled by ReadEnv itself cannot be overridden. A later
+// registration for the same type replaces the earlier one. RegisterParser is
+// safe for concurrent use but is typically called from an init function.
+func RegisterParser(sample any, parse func(string) (any, error)) {
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+	parsers[reflect.TypeOf(sample)] = parse
+}
+
+// parseRegistered converts raw with the parser registered for T. ok is false
+// if there is none.
+func parseRegistered[T any](raw string) (result T, ok bool, err error) {
+	t := reflect.TypeFor[T]()
+	parsersMu.RLock()
+	parse, ok := parsers[t]
+	parsersMu.RUnlock()
+	if !ok {
+		return result, false, nil
+	}
+
+	val, err := parse(raw)
+	if err != nil {
+		return result, true, &ConversionError{Value: raw, TargetType: t.String(), Err: err}
+	}
+	result, isT := val.(T)
+	if !isT {
+		return result, true, fmt.Errorf("parser registered for %s returned %T", t, val)
+	}
+	return result, true, nil
+}
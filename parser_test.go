@@ -0,0 +1,81 @@
+package envreader
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type version struct {
+	Major, Minor int
+}
+
+// unregisterParser removes the parser for the type of sample when the test
+// ends.
+func unregisterParser(t *testing.T, sample any) {
+	t.Cleanup(func() {
+		parsersMu.Lock()
+		defer parsersMu.Unlock()
+		delete(parsers, reflect.TypeOf(sample))
+	})
+}
+
+func TestRegisterParser(t *testing.T) {
+	RegisterParser(version{}, func(s string) (any, error) {
+		var v version
+		if _, err := fmt.Sscanf(s, "%d.%d", &v.Major, &v.Minor); err != nil {
+			return nil, err
+		}
+		return v, nil
+	})
+	unregisterParser(t, version{})
+
+	t.Run("Registered", func(t *testing.T) {
+		t.Setenv("TEST_PARSER_VERSION", "1.22")
+		got, err := ReadEnv("TEST_PARSER_VERSION", version{})
+		if err != nil || got != (version{1, 22}) {
+			t.Errorf("ReadEnv[version] = %+v, %v; want {Major:1 Minor:22}, nil", got, err)
+		}
+	})
+
+	t.Run("Registered_SliceElements", func(t *testing.T) {
+		t.Setenv("TEST_PARSER_VERSION", "1.21,1.22")
+		got, err := ReadEnvTypedSlice[version]("TEST_PARSER_VERSION", nil, ",")
+		if err != nil || len(got) != 2 || got[1] != (version{1, 22}) {
+			t.Errorf("ReadEnvTypedSlice[version] = %+v, %v; want [{1 21} {1 22}], nil", got, err)
+		}
+	})
+
+	t.Run("ParserError", func(t *testing.T) {
+		t.Setenv("TEST_PARSER_VERSION", "latest")
+		def := version{1, 0}
+		got, err := ReadEnv("TEST_PARSER_VERSION", def)
+		var convErr *ConversionError
+		if got != def || !errors.As(err, &convErr) || convErr.Key != "TEST_PARSER_VERSION" || convErr.TargetType != "envreader.version" {
+			t.Errorf("ReadEnv[version] = %+v, %v; want default and an envreader.version ConversionError", got, err)
+		}
+	})
+
+	t.Run("TypeMismatch", func(t *testing.T) {
+		type build string
+		RegisterParser(build(""), func(s string) (any, error) { return s, nil }) // Returns string, not build
+		unregisterParser(t, build(""))
+
+		t.Setenv("TEST_PARSER_BUILD", "abc123")
+		got, err := ReadEnv("TEST_PARSER_BUILD", build("dev"))
+		if got != "dev" || err == nil || !strings.Contains(err.Error(), "returned string") {
+			t.Errorf("ReadEnv[build] = %q, %v; want %q and a type mismatch error", got, err, "dev")
+		}
+	})
+
+	t.Run("Unregistered", func(t *testing.T) {
+		type unknown struct{}
+		t.Setenv("TEST_PARSER_UNKNOWN", "x")
+		_, err := ReadEnv("TEST_PARSER_UNKNOWN", unknown{})
+		if err == nil || !strings.HasPrefix(err.Error(), "unsupported type for environment variable conversion") {
+			t.Errorf("ReadEnv[unknown] returned error %v; want unsupported type", err)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package envreader
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+// EnvReader reads variables that share a common prefix. A reader created with
+// NewEnvReader("SERVICE_A") resolves "PORT" to "SERVICE_A_PORT".
+type EnvReader struct {
+	prefix string
+}
+
+// NewEnvReader returns an EnvReader for prefix. An empty prefix reads keys
+// unchanged, and a trailing underscore is optional, so "SERVICE_A_" is the
+// same as "SERVICE_A".
+func NewEnvReader(prefix string) *EnvReader {
+	return &EnvReader{prefix: prefix}
+}
+
+// Key returns the fully qualified variable name for key.
+func (r *EnvReader) Key(key string) string {
+	return keyPrefix(r.prefix) + key
+}
+
+// keyPrefix returns prefix with exactly one separating underscore appended,
+// or "" for an empty prefix. EnvReader and Snapshot both use it so that they
+// name variables alike.
+func keyPrefix(prefix string) string {
+	if prefix == "" {
+		return ""
+	}
+	return strings.TrimSuffix(prefix, "_") + "_"
+}
+
+// Sub returns a reader for a nested prefix, so NewEnvReader("SERVICE_A").Sub("DB")
+// resolves "HOST" to "SERVICE_A_DB_HOST". An empty subPrefix returns a reader
+// with the same prefix as r.
+func (r *EnvReader) Sub(subPrefix string) *EnvReader {
+	if subPrefix == "" {
+		return NewEnvReader(r.prefix)
+	}
+	return NewEnvReader(r.Key(subPrefix))
+}
+
+// Read is the generic form of the EnvReader methods: it calls ReadEnv with the
+// prefixed key. It is a function because Go methods cannot take type
+// parameters.
+func Read[T any](r *EnvReader, key string, defaultValue T) (T, error) {
+	return ReadEnv(r.Key(key), defaultValue)
+}
+
+// Int reads the prefixed key as an int.
+func (r *EnvReader) Int(key string, defaultValue int) (int, error) {
+	return Read(r, key, defaultValue)
+}
+
+// Int64 reads the prefixed key as an int64.
+func (r *EnvReader) Int64(key string, defaultValue int64) (int64, error) {
+	return Read(r, key, defaultValue)
+}
+
+// String reads the prefixed key as a string.
+func (r *EnvReader) String(key string, defaultValue string) (string, error) {
+	return Read(r, key, defaultValue)
+}
+
+// Bool reads the prefixed key as a bool.
+func (r *EnvReader) Bool(key string, defaultValue bool) (bool, error) {
+	return Read(r, key, defaultValue)
+}
+
+// Float64 reads the prefixed key as a float64.
+func (r *EnvReader) Float64(key string, defaultValue float64) (float64, error) {
+	return Read(r, key, defaultValue)
+}
+
+// Duration reads the prefixed key as a time.Duration.
+func (r *EnvReader) Duration(key string, defaultValue time.Duration) (time.Duration, error) {
+	return Read(r, key, defaultValue)
+}
+
+// Snapshot returns every environment variable under prefix, keyed by the name
+// with the prefix and its separating underscore removed. It uses the same
+// naming as EnvReader, so Snapshot("SERVICE_A") and Snapshot("SERVICE_A_")
+// both report SERVICE_A_PORT as "PORT" but ignore SERVICE_AB_PORT. An empty
+// prefix returns the whole environment.
+func Snapshot(prefix string) map[string]string {
+	prefix = keyPrefix(prefix)
+	result := make(map[string]string)
+	for _, kv := range os.Environ() {
+		key, value, _ := strings.Cut(kv, "=")
+		name, ok := strings.CutPrefix(key, prefix)
+		if !ok || name == "" {
+			continue
+		}
+		result[name] = value
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+package envreader
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEnvReader(t *testing.T) {
+	t.Run("PrefixConcatenation", func(t *testing.T) {
+		t.Setenv("SERVICE_A_PORT", "9000")
+		t.Setenv("SERVICE_B_PORT", "9001")
+
+		r := NewEnvReader("SERVICE_A")
+		if key := r.Key("PORT"); key != "SERVICE_A_PORT" {
+			t.Errorf("Key(%q) = %q; want %q", "PORT", key, "SERVICE_A_PORT")
+		}
+		got, err := r.Int("PORT", 8080)
+		if err != nil || got != 9000 {
+			t.Errorf("Int(%q, 8080) = %v, %v; want 9000, nil", "PORT", got, err)
+		}
+	})
+
+	t.Run("PrefixedKeyNotSet_ReturnsDefault", func(t *testing.T) {
+		t.Setenv("PORT", "9002")
+		os.Unsetenv("SERVICE_C_PORT")
+
+		got, err := NewEnvReader("SERVICE_C").Int("PORT", 8080)
+		if err != nil || got != 8080 {
+			t.Errorf("Int(%q, 8080) = %v, %v; want 8080, nil", "PORT", got, err)
+		}
+	})
+
+	t.Run("EmptyPrefix_MatchesBareFunctions", func(t *testing.T) {
+		t.Setenv("TEST_READER_TIMEOUT", "3s")
+
+		r := NewEnvReader("")
+		got, err := r.Duration("TEST_READER_TIMEOUT", time.Second)
+		want, wantErr := ReadEnv("TEST_READER_TIMEOUT", time.Second)
+		if got != want || err != wantErr {
+			t.Errorf("Duration(%q) = %v, %v; want %v, %v", "TEST_READER_TIMEOUT", got, err, want, wantErr)
+		}
+	})
+
+	t.Run("TrailingUnderscore", func(t *testing.T) {
+		if key := NewEnvReader("SERVICE_A_").Key("PORT"); key != "SERVICE_A_PORT" {
+			t.Errorf("Key(%q) = %q; want %q", "PORT", key, "SERVICE_A_PORT")
+		}
+		if key := NewEnvReader("SERVICE_A").Sub("DB_").Key("HOST"); key != "SERVICE_A_DB_HOST" {
+			t.Errorf("Sub(%q).Key(%q) = %q; want %q", "DB_", "HOST", key, "SERVICE_A_DB_HOST")
+		}
+	})
+
+	t.Run("GenericRead", func(t *testing.T) {
+		t.Setenv("SERVICE_A_RATIO", "0.75")
+
+		got, err := Read(NewEnvReader("SERVICE_A"), "RATIO", float32(0))
+		if err != nil || got != 0.75 {
+			t.Errorf("Read[float32](%q) = %v, %v; want 0.75, nil", "RATIO", got, err)
+		}
+	})
+}
+
+func TestEnvReaderSub(t *testing.T) {
+	t.Run("TwoLevels", func(t *testing.T) {
+		t.Setenv("SERVICE_A_DB_PRIMARY_HOST", "db1.internal")
+
+		db := NewEnvReader("SERVICE_A").Sub("DB")
+		if key := db.Key("HOST"); key != "SERVICE_A_DB_HOST" {
+			t.Errorf("Sub(%q).Key(%q) = %q; want %q", "DB", "HOST", key, "SERVICE_A_DB_HOST")
+		}
+		primary := db.Sub("PRIMARY")
+		if key := primary.Key("HOST"); key != "SERVICE_A_DB_PRIMARY_HOST" {
+			t.Errorf("Sub(%q).Sub(%q).Key(%q) = %q; want %q", "DB", "PRIMARY", "HOST", key, "SERVICE_A_DB_PRIMARY_HOST")
+		}
+		got, err := primary.String("HOST", "localhost")
+		if err != nil || got != "db1.internal" {
+			t.Errorf("String(%q) = %q, %v; want %q, nil", "HOST", got, err, "db1.internal")
+		}
+	})
+
+	t.Run("EmptySubPrefix", func(t *testing.T) {
+		r := NewEnvReader("SERVICE_A")
+		sub := r.Sub("")
+		if sub == r {
+			t.Error("Sub(\"\") returned the receiver; want a new reader")
+		}
+		if key := sub.Key("PORT"); key != "SERVICE_A_PORT" {
+			t.Errorf("Sub(\"\").Key(%q) = %q; want %q", "PORT", key, "SERVICE_A_PORT")
+		}
+	})
+
+	t.Run("EmptyParentPrefix", func(t *testing.T) {
+		if key := NewEnvReader("").Sub("DB").Key("HOST"); key != "DB_HOST" {
+			t.Errorf("NewEnvReader(\"\").Sub(%q).Key(%q) = %q; want %q", "DB", "HOST", key, "DB_HOST")
+		}
+	})
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Setenv("SNAPSHOT_APP_PORT", "8080")
+	t.Setenv("SNAPSHOT_APP_DB_HOST", "db")
+	t.Setenv("SNAPSHOT_APP_EMPTY", "")
+	t.Setenv("SNAPSHOT_APPLE_PORT", "1")
+	t.Setenv("SNAPSHOT_OTHER_PORT", "2")
+
+	want := map[string]string{
+		"PORT":    "8080",
+		"DB_HOST": "db",
+		"EMPTY":   "",
+	}
+	for _, prefix := range []string{"SNAPSHOT_APP", "SNAPSHOT_APP_"} {
+		if got := Snapshot(prefix); !reflect.DeepEqual(got, want) {
+			t.Errorf("Snapshot(%q) = %v; want %v", prefix, got, want)
+		}
+	}
+
+	t.Run("RoundTripsThroughEnvReader", func(t *testing.T) {
+		for _, prefix := range []string{"SNAPSHOT_APP", "SNAPSHOT_APP_"} {
+			r := NewEnvReader(prefix)
+			for name, value := range Snapshot(prefix) {
+				if got, _ := r.String(name, "missing"); got != value {
+					t.Errorf("NewEnvReader(%q).String(%q) = %q; want %q", prefix, name, got, value)
+				}
+			}
+		}
+	})
+
+	t.Run("EmptyPrefix", func(t *testing.T) {
+		if got := Snapshot(""); got["SNAPSHOT_OTHER_PORT"] != "2" {
+			t.Errorf("Snapshot(\"\")[%q] = %q; want %q", "SNAPSHOT_OTHER_PORT", got["SNAPSHOT_OTHER_PORT"], "2")
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package envreader
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ReadEnvRegexp reads key as a regular expression such as "^/api/.*" and
+// compiles it with regexp.Compile. An invalid pattern yields defaultValue and
+// an error wrapping the *syntax.Error. If the variable is unset or empty,
+// defaultValue is returned.
+func ReadEnvRegexp(key string, defaultValue *regexp.Regexp) (*regexp.Regexp, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	re, err := regexp.Compile(envValue)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to *regexp.Regexp: %w", envValue, err)
+	}
+	return re, nil
+}
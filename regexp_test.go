@@ -0,0 +1,43 @@
+package envreader
+
+import (
+	"errors"
+	"os"
+	"regexp"
+	"regexp/syntax"
+	"testing"
+)
+
+func TestReadEnvRegexp(t *testing.T) {
+	def := regexp.MustCompile(`^/$`)
+
+	t.Run("ValidPattern", func(t *testing.T) {
+		t.Setenv("TEST_REGEXP", `^/api/.*`)
+		got, err := ReadEnvRegexp("TEST_REGEXP", def)
+		if err != nil || got.String() != `^/api/.*` {
+			t.Fatalf("ReadEnvRegexp(%q) = %v, %v; want compiled pattern, nil", `^/api/.*`, got, err)
+		}
+		if !got.MatchString("/api/users") || got.MatchString("/web") {
+			t.Errorf("ReadEnvRegexp(%q) returned a pattern that matches incorrectly", `^/api/.*`)
+		}
+	})
+
+	t.Run("InvalidPattern", func(t *testing.T) {
+		t.Setenv("TEST_REGEXP", `^/api/(v1`)
+		got, err := ReadEnvRegexp("TEST_REGEXP", def)
+		if got != def {
+			t.Errorf("ReadEnvRegexp(%q) returned value %v; want default %v", `^/api/(v1`, got, def)
+		}
+		var syntaxErr *syntax.Error
+		if !errors.As(err, &syntaxErr) || syntaxErr.Code != syntax.ErrMissingParen {
+			t.Errorf("ReadEnvRegexp(%q) returned error %v; want *syntax.Error with %v", `^/api/(v1`, err, syntax.ErrMissingParen)
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_REGEXP")
+		if got, err := ReadEnvRegexp("TEST_REGEXP", def); got != def || err != nil {
+			t.Errorf("ReadEnvRegexp = %v, %v; want default, nil", got, err)
+		}
+	})
+}
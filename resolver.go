@@ -0,0 +1,32 @@
+package envreader
+
+// Resolver reads a key from several sources in priority order, such as
+// command-line flags, then the environment (GetRaw or os.LookupEnv), then a
+// defaults file loaded into a map.
+type Resolver struct {
+	sources []func(key string) (string, bool)
+}
+
+// NewResolver returns a Resolver that consults sources in the given order.
+func NewResolver(sources ...func(key string) (string, bool)) *Resolver {
+	return &Resolver{sources: sources}
+}
+
+// Lookup returns the value of key from the first source that has it set to a
+// non-empty value. It has the signature expected by ReadEnvFrom.
+func (r *Resolver) Lookup(key string) (string, bool) {
+	for _, source := range r.sources {
+		if value, ok := source(key); ok && value != "" {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// Resolve reads key through r and converts it to T like ReadEnv. Later sources
+// are not consulted once a value is found, even if it fails to convert. If no
+// source has the key, defaultValue is returned. It is a function because Go
+// methods cannot take type parameters.
+func Resolve[T any](r *Resolver, key string, defaultValue T) (T, error) {
+	return ReadEnvFrom(r.Lookup, key, defaultValue)
+}
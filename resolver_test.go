@@ -0,0 +1,61 @@
+package envreader
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+// mapSource returns a Resolver source backed by m.
+func mapSource(m map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := m[key]
+		return value, ok
+	}
+}
+
+func TestResolve(t *testing.T) {
+	flags := mapSource(map[string]string{"PORT": "9090", "EMPTY": "", "BAD": "many"})
+	env := mapSource(map[string]string{"PORT": "8080", "HOST": "localhost", "EMPTY": "7", "BAD": "3"})
+	r := NewResolver(flags, env)
+
+	t.Run("HigherPriorityWins", func(t *testing.T) {
+		if got, err := Resolve(r, "PORT", 80); got != 9090 || err != nil {
+			t.Errorf("Resolve(%q) = %v, %v; want 9090, nil", "PORT", got, err)
+		}
+	})
+
+	t.Run("FallsThrough", func(t *testing.T) {
+		if got, err := Resolve(r, "HOST", "0.0.0.0"); got != "localhost" || err != nil {
+			t.Errorf("Resolve(%q) = %q, %v; want %q, nil", "HOST", got, err, "localhost")
+		}
+	})
+
+	t.Run("EmptyValueFallsThrough", func(t *testing.T) {
+		if got, err := Resolve(r, "EMPTY", 0); got != 7 || err != nil {
+			t.Errorf("Resolve(%q) = %v, %v; want 7, nil", "EMPTY", got, err)
+		}
+	})
+
+	t.Run("InvalidValueDoesNotFallThrough", func(t *testing.T) {
+		if got, err := Resolve(r, "BAD", 1); got != 1 || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("Resolve(%q) = %v, %v; want 1, strconv.ErrSyntax", "BAD", got, err)
+		}
+	})
+
+	t.Run("NoSourceHasKey", func(t *testing.T) {
+		if got, err := Resolve(r, "MISSING", 80); got != 80 || err != nil {
+			t.Errorf("Resolve(%q) = %v, %v; want 80, nil", "MISSING", got, err)
+		}
+		if got, err := Resolve(NewResolver(), "PORT", 80); got != 80 || err != nil {
+			t.Errorf("Resolve(%q) with no sources = %v, %v; want 80, nil", "PORT", got, err)
+		}
+	})
+
+	t.Run("EnvSource", func(t *testing.T) {
+		t.Setenv("TEST_RESOLVE_TIMEOUT", "250")
+		if got, err := Resolve(NewResolver(flags, GetRaw), "TEST_RESOLVE_TIMEOUT", 0); got != 250 || err != nil {
+			t.Errorf("Resolve(%q) = %v, %v; want 250, nil", "TEST_RESOLVE_TIMEOUT", got, err)
+		}
+	})
+}
@@ -0,0 +1,173 @@
+package envreader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultSeparator is the separator used by the slice readers that do not take
+// one explicitly, such as ReadEnvSliceDefault.
+var DefaultSeparator = ","
+
+// ReadEnvSlice reads key and splits it on sep into a list of strings. Each
+// element is trimmed of surrounding whitespace and empty elements are dropped,
+// so a trailing separator does not produce an empty entry. If the variable is
+// unset or empty, defaultValue is returned.
+func ReadEnvSlice(key string, defaultValue []string, sep string) ([]string, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+	return splitList(envValue, sep), nil
+}
+
+// ReadEnvSliceDefault is like ReadEnvSlice using DefaultSeparator.
+func ReadEnvSliceDefault(key string, defaultValue []string) ([]string, error) {
+	return ReadEnvSlice(key, defaultValue, DefaultSeparator)
+}
+
+// ReadEnvSet is like ReadEnvSlice but returns the elements as a set, so
+// "alice,bob,alice" yields two members. If the variable is unset or empty,
+// defaultValue is returned.
+func ReadEnvSet(key string, defaultValue map[string]struct{}, sep string) (map[string]struct{}, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	parts := splitList(envValue, sep)
+	result := make(map[string]struct{}, len(parts))
+	for _, part := range parts {
+		result[part] = struct{}{}
+	}
+	return result, nil
+}
+
+// ReadEnvIntSlice reads key as a list of ints separated by sep, such as
+// "1,2,4,8". Elements are trimmed and empty elements are skipped. If any
+// element fails to parse, defaultValue is returned with an error naming the
+// element's index and value. If the variable is unset or empty, defaultValue
+// is returned.
+func ReadEnvIntSlice(key string, defaultValue []int, sep string) ([]int, error) {
+	return readSlice(key, defaultValue, sep, strconv.Atoi)
+}
+
+// ReadEnvIntSliceDefault is like ReadEnvIntSlice using DefaultSeparator.
+func ReadEnvIntSliceDefault(key string, defaultValue []int) ([]int, error) {
+	return ReadEnvIntSlice(key, defaultValue, DefaultSeparator)
+}
+
+// ReadEnvInt64Slice is like ReadEnvIntSlice for int64 elements.
+func ReadEnvInt64Slice(key string, defaultValue []int64, sep string) ([]int64, error) {
+	return readSlice(key, defaultValue, sep, func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	})
+}
+
+// ReadEnvValidatedSlice is like ReadEnvSlice but requires every element to be
+// one of allowed, compared case-sensitively, as for TLS_VERSIONS=1.2,1.3. A
+// disallowed element yields defaultValue and an error naming it.
+func ReadEnvValidatedSlice(key string, defaultValue []string, sep string, allowed []string) ([]string, error) {
+	return readSlice(key, defaultValue, sep, func(s string) (string, error) {
+		if !slices.Contains(allowed, s) {
+			return "", fmt.Errorf("not in allowed set %v", allowed)
+		}
+		return s, nil
+	})
+}
+
+// ReadEnvBoolSlice is like ReadEnvIntSlice for booleans such as
+// "true,off,yes", accepting the same keywords as ReadEnvBool.
+func ReadEnvBoolSlice(key string, defaultValue []bool, sep string) ([]bool, error) {
+	return readSlice(key, defaultValue, sep, parseBoolKeyword)
+}
+
+// ReadEnvDurationSlice is like ReadEnvIntSlice for durations such as
+// "1s,2s,4s", parsing each element with time.ParseDuration.
+func ReadEnvDurationSlice(key string, defaultValue []time.Duration, sep string) ([]time.Duration, error) {
+	return readSlice(key, defaultValue, sep, time.ParseDuration)
+}
+
+// ReadEnvPathList reads key as a list of paths separated by
+// os.PathListSeparator, such as PATH (':' on Unix, ';' on Windows). Empty
+// entries are dropped and each path is cleaned with filepath.Clean. If the
+// variable is unset or empty, defaultValue is returned.
+func ReadEnvPathList(key string, defaultValue []string) ([]string, error) {
+	return readPathList(key, defaultValue, string(os.PathListSeparator))
+}
+
+// readPathList implements ReadEnvPathList for an explicit separator.
+func readPathList(key string, defaultValue []string, sep string) ([]string, error) {
+	return readSlice(key, defaultValue, sep, func(s string) (string, error) {
+		return filepath.Clean(s), nil
+	})
+}
+
+// ReadEnvTypedSlice reads key as a list separated by sep and converts each
+// element to T with the same rules as ReadEnv, so ReadEnvTypedSlice[float64]
+// or ReadEnvTypedSlice[time.Duration] work like ReadEnvIntSlice. Elements are
+// trimmed and empty elements are skipped. If any element fails to convert,
+// defaultValue is returned with an error naming the element's index.
+func ReadEnvTypedSlice[T any](key string, defaultValue []T, sep string) ([]T, error) {
+	return readSlice(key, defaultValue, sep, func(s string) (T, error) {
+		var zero T
+		return convert(key, s, zero)
+	})
+}
+
+// ReadEnvFlexibleSlice reads key as either a JSON array such as "[1,2,3]" or a
+// list separated by sep such as "1,2,3". A value starting with '[' after
+// trimming is decoded like ReadEnvJSONSlice; anything else is split and
+// converted like ReadEnvTypedSlice. If the variable is unset, empty or only
+// whitespace, defaultValue is returned.
+func ReadEnvFlexibleSlice[T any](key string, defaultValue []T, sep string) ([]T, error) {
+	envValue, _ := GetRaw(key)
+	trimmed := strings.TrimSpace(envValue)
+	switch {
+	case trimmed == "":
+		return defaultValue, nil
+	case strings.HasPrefix(trimmed, "["):
+		return ReadEnvJSONSlice(key, defaultValue)
+	}
+	return ReadEnvTypedSlice(key, defaultValue, sep)
+}
+
+// readSlice splits the value of key with splitList and converts each element
+// with parse.
+func readSlice[T any](key string, defaultValue []T, sep string, parse func(string) (T, error)) ([]T, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	parts := splitList(envValue, sep)
+	result := make([]T, 0, len(parts))
+	for i, part := range parts {
+		val, err := parse(part)
+		if err != nil {
+			return defaultValue, fmt.Errorf("failed to convert element %d (%q) of %q: %w", i, part, key, err)
+		}
+		result = append(result, val)
+	}
+	return result, nil
+}
+
+// splitList splits s on sep, trims each element and drops empty ones. The
+// result is never nil.
+func splitList(s, sep string) []string {
+	parts := strings.Split(s, sep)
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		result = append(result, part)
+	}
+	return result
+}
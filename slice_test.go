@@ -0,0 +1,512 @@
+package envreader
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestReadEnvSlice(t *testing.T) {
+	tests := []struct {
+		name         string
+		envValue     string
+		setEnv       bool
+		sep          string
+		defaultValue []string
+		expectedVal  []string
+	}{
+		{
+			name:         "Comma",
+			envValue:     "a.com,b.com,c.com",
+			setEnv:       true,
+			sep:          ",",
+			defaultValue: nil,
+			expectedVal:  []string{"a.com", "b.com", "c.com"},
+		},
+		{
+			name:         "Comma_WhitespaceAndTrailingSeparator",
+			envValue:     " a , b ,",
+			setEnv:       true,
+			sep:          ",",
+			defaultValue: nil,
+			expectedVal:  []string{"a", "b"},
+		},
+		{
+			name:         "Pipe",
+			envValue:     "x|y|z",
+			setEnv:       true,
+			sep:          "|",
+			defaultValue: nil,
+			expectedVal:  []string{"x", "y", "z"},
+		},
+		{
+			name:         "Space",
+			envValue:     "one  two three ",
+			setEnv:       true,
+			sep:          " ",
+			defaultValue: nil,
+			expectedVal:  []string{"one", "two", "three"},
+		},
+		{
+			name:         "OnlySeparators",
+			envValue:     ",,,",
+			setEnv:       true,
+			sep:          ",",
+			defaultValue: []string{"default"},
+			expectedVal:  []string{}, // Empty but non-nil
+		},
+		{
+			name:         "EnvNotExists",
+			setEnv:       false,
+			sep:          ",",
+			defaultValue: []string{"default"},
+			expectedVal:  []string{"default"},
+		},
+		{
+			name:         "EnvExists_EmptyValue",
+			envValue:     "",
+			setEnv:       true,
+			sep:          ",",
+			defaultValue: []string{"default"},
+			expectedVal:  []string{"default"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_STRING_SLICE", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_STRING_SLICE")
+			}
+
+			actualVal, err := ReadEnvSlice("TEST_STRING_SLICE", tt.defaultValue, tt.sep)
+			if err != nil {
+				t.Fatalf("ReadEnvSlice returned unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(actualVal, tt.expectedVal) {
+				t.Errorf("ReadEnvSlice(%q, %v, %q) = %#v; want %#v", "TEST_STRING_SLICE", tt.defaultValue, tt.sep, actualVal, tt.expectedVal)
+			}
+		})
+	}
+}
+
+func TestReadEnvSet(t *testing.T) {
+	def := map[string]struct{}{"root": {}}
+
+	tests := []struct {
+		name        string
+		envValue    string
+		setEnv      bool
+		expectedVal map[string]struct{}
+	}{
+		{name: "Deduplicated", envValue: "a,a,b", setEnv: true, expectedVal: map[string]struct{}{"a": {}, "b": {}}},
+		{name: "WhitespaceTrimmed", envValue: " alice , bob,alice ", setEnv: true, expectedVal: map[string]struct{}{"alice": {}, "bob": {}}},
+		{name: "EmptyElementsDropped", envValue: "a,,b,", setEnv: true, expectedVal: map[string]struct{}{"a": {}, "b": {}}},
+		{name: "EnvExists_EmptyValue", envValue: "", setEnv: true, expectedVal: def},
+		{name: "EnvNotExists", setEnv: false, expectedVal: def},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_SET", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_SET")
+			}
+
+			actualVal, actualErr := ReadEnvSet("TEST_SET", def, ",")
+			if actualErr != nil || !reflect.DeepEqual(actualVal, tt.expectedVal) {
+				t.Errorf("ReadEnvSet(%q) = %v, %v; want %v, nil", tt.envValue, actualVal, actualErr, tt.expectedVal)
+			}
+		})
+	}
+}
+
+func TestReadEnvIntSlice(t *testing.T) {
+	def := []int{1}
+
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       []int
+		expectedErr       error
+		expectedErrString string
+	}{
+		{name: "Clean", envValue: "1,2,4,8", setEnv: true, expectedVal: []int{1, 2, 4, 8}},
+		{name: "WhitespacePadded", envValue: " 1 , 2,\t3 ", setEnv: true, expectedVal: []int{1, 2, 3}},
+		{name: "TrailingSeparator", envValue: "1,2,", setEnv: true, expectedVal: []int{1, 2}},
+		{name: "Negative", envValue: "-1,0", setEnv: true, expectedVal: []int{-1, 0}},
+		{name: "EnvNotExists", setEnv: false, expectedVal: def},
+		{
+			name:              "BadElement",
+			envValue:          "1,two,3",
+			setEnv:            true,
+			expectedVal:       def,
+			expectedErr:       strconv.ErrSyntax,
+			expectedErrString: `failed to convert element 1 ("two") of "TEST_INT_SLICE": strconv.Atoi: parsing "two": invalid syntax`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_INT_SLICE", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_INT_SLICE")
+			}
+
+			actualVal, actualErr := ReadEnvIntSlice("TEST_INT_SLICE", def, ",")
+			if !reflect.DeepEqual(actualVal, tt.expectedVal) {
+				t.Errorf("ReadEnvIntSlice(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvIntSlice(%q) returned error %v; want %v", tt.envValue, actualErr, tt.expectedErr)
+			}
+			if tt.expectedErrString != "" && (actualErr == nil || actualErr.Error() != tt.expectedErrString) {
+				t.Errorf("ReadEnvIntSlice(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvInt64Slice(t *testing.T) {
+	t.Setenv("TEST_INT64_SLICE", "9223372036854775807|-1")
+	got, err := ReadEnvInt64Slice("TEST_INT64_SLICE", nil, "|")
+	want := []int64{9223372036854775807, -1}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadEnvInt64Slice = %v, %v; want %v, nil", got, err, want)
+	}
+
+	t.Setenv("TEST_INT64_SLICE", "1|9223372036854775808")
+	if _, err := ReadEnvInt64Slice("TEST_INT64_SLICE", nil, "|"); !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("ReadEnvInt64Slice error = %v; want strconv.ErrRange", err)
+	}
+}
+
+func TestReadEnvValidatedSlice(t *testing.T) {
+	def := []string{"1.3"}
+	allowed := []string{"1.0", "1.1", "1.2", "1.3"}
+
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       []string
+		expectedErrString string
+	}{
+		{name: "AllValid", envValue: "1.2, 1.3", setEnv: true, expectedVal: []string{"1.2", "1.3"}},
+		{
+			name:              "OneDisallowed",
+			envValue:          "1.2,1.4,1.3",
+			setEnv:            true,
+			expectedVal:       def,
+			expectedErrString: `failed to convert element 1 ("1.4") of "TEST_VALIDATED_SLICE": not in allowed set [1.0 1.1 1.2 1.3]`,
+		},
+		{name: "EmptyInput", envValue: "", setEnv: true, expectedVal: def},
+		{name: "OnlySeparators", envValue: " , ", setEnv: true, expectedVal: []string{}},
+		{name: "EnvNotExists", setEnv: false, expectedVal: def},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_VALIDATED_SLICE", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_VALIDATED_SLICE")
+			}
+
+			actualVal, actualErr := ReadEnvValidatedSlice("TEST_VALIDATED_SLICE", def, ",", allowed)
+			if !reflect.DeepEqual(actualVal, tt.expectedVal) {
+				t.Errorf("ReadEnvValidatedSlice(%q) returned value %q; want %q", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvValidatedSlice(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+			} else if actualErr == nil || actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvValidatedSlice(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvBoolSlice(t *testing.T) {
+	def := []bool{true}
+
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       []bool
+		expectedErr       error
+		expectedErrString string
+	}{
+		{name: "Clean", envValue: "true,false,true", setEnv: true, expectedVal: []bool{true, false, true}},
+		{name: "Keywords", envValue: " yes , OFF,1,disabled ", setEnv: true, expectedVal: []bool{true, false, true, false}},
+		{name: "EnvNotExists", setEnv: false, expectedVal: def},
+		{
+			name:              "BadElement",
+			envValue:          "true,maybe",
+			setEnv:            true,
+			expectedVal:       def,
+			expectedErr:       strconv.ErrSyntax,
+			expectedErrString: `failed to convert element 1 ("maybe") of "TEST_BOOL_SLICE": invalid syntax`,
+		},
+		{
+			name:              "UnsupportedKeyword",
+			envValue:          "yes,n",
+			setEnv:            true,
+			expectedVal:       def,
+			expectedErr:       strconv.ErrSyntax,
+			expectedErrString: `failed to convert element 1 ("n") of "TEST_BOOL_SLICE": invalid syntax`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_BOOL_SLICE", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_BOOL_SLICE")
+			}
+
+			actualVal, actualErr := ReadEnvBoolSlice("TEST_BOOL_SLICE", def, ",")
+			if !reflect.DeepEqual(actualVal, tt.expectedVal) {
+				t.Errorf("ReadEnvBoolSlice(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvBoolSlice(%q) returned error %v; want %v", tt.envValue, actualErr, tt.expectedErr)
+			}
+			if tt.expectedErrString != "" && (actualErr == nil || actualErr.Error() != tt.expectedErrString) {
+				t.Errorf("ReadEnvBoolSlice(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvDurationSlice(t *testing.T) {
+	def := []time.Duration{time.Second}
+
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       []time.Duration
+		expectedErrString string
+	}{
+		{name: "Clean", envValue: "1s,2s,4s", setEnv: true, expectedVal: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}},
+		{name: "WhitespacePadded", envValue: " 100ms , 1m30s ", setEnv: true, expectedVal: []time.Duration{100 * time.Millisecond, 90 * time.Second}},
+		{name: "TrailingSeparator", envValue: "1s,2s,", setEnv: true, expectedVal: []time.Duration{time.Second, 2 * time.Second}},
+		{name: "EnvNotExists", setEnv: false, expectedVal: def},
+		{
+			name:              "BadElement",
+			envValue:          "1s,2,4s",
+			setEnv:            true,
+			expectedVal:       def,
+			expectedErrString: `failed to convert element 1 ("2") of "TEST_DURATION_SLICE": time: missing unit in duration "2"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_DURATION_SLICE", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_DURATION_SLICE")
+			}
+
+			actualVal, actualErr := ReadEnvDurationSlice("TEST_DURATION_SLICE", def, ",")
+			if !reflect.DeepEqual(actualVal, tt.expectedVal) {
+				t.Errorf("ReadEnvDurationSlice(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvDurationSlice(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+			} else if actualErr == nil || actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvDurationSlice(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvPathList(t *testing.T) {
+	def := []string{"/usr/lib/plugins"}
+
+	tests := []struct {
+		name        string
+		envValue    string
+		setEnv      bool
+		sep         string
+		expectedVal []string
+	}{
+		{name: "Unix", envValue: "/opt/plugins:/home/me/plugins/", setEnv: true, sep: ":", expectedVal: []string{"/opt/plugins", "/home/me/plugins"}},
+		{name: "Unix_EmptyEntries", envValue: ":/opt/a::/opt/b:", setEnv: true, sep: ":", expectedVal: []string{"/opt/a", "/opt/b"}},
+		{name: "Unix_Cleaned", envValue: "/opt/./a/../b", setEnv: true, sep: ":", expectedVal: []string{"/opt/b"}},
+		{name: "Windows", envValue: `C:\Plugins;D:\More Plugins;`, setEnv: true, sep: ";", expectedVal: []string{`C:\Plugins`, `D:\More Plugins`}},
+		{name: "EnvNotExists", setEnv: false, sep: ":", expectedVal: def},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_PATH_LIST", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_PATH_LIST")
+			}
+
+			// filepath.Clean uses the platform's path separator.
+			want := make([]string, len(tt.expectedVal))
+			for i, p := range tt.expectedVal {
+				want[i] = filepath.FromSlash(p)
+			}
+			if !tt.setEnv {
+				want = def
+			}
+
+			actualVal, actualErr := readPathList("TEST_PATH_LIST", def, tt.sep)
+			if actualErr != nil || !reflect.DeepEqual(actualVal, want) {
+				t.Errorf("readPathList(%q, %q) = %q, %v; want %q, nil", tt.envValue, tt.sep, actualVal, actualErr, want)
+			}
+		})
+	}
+
+	t.Run("PlatformSeparator", func(t *testing.T) {
+		sep := string(os.PathListSeparator)
+		t.Setenv("TEST_PATH_LIST", "a"+sep+sep+"b")
+		got, err := ReadEnvPathList("TEST_PATH_LIST", def)
+		if want := []string{"a", "b"}; err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadEnvPathList = %q, %v; want %q, nil", got, err, want)
+		}
+	})
+}
+
+func TestDefaultSeparator(t *testing.T) {
+	t.Cleanup(func() { DefaultSeparator = "," })
+	t.Setenv("TEST_DEFAULT_SEP", "a,b;c")
+
+	got, err := ReadEnvSliceDefault("TEST_DEFAULT_SEP", nil)
+	if want := []string{"a", "b;c"}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadEnvSliceDefault with %q = %v, %v; want %v, nil", ",", got, err, want)
+	}
+
+	DefaultSeparator = ";"
+	got, err = ReadEnvSliceDefault("TEST_DEFAULT_SEP", nil)
+	if want := []string{"a,b", "c"}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadEnvSliceDefault with %q = %v, %v; want %v, nil", ";", got, err, want)
+	}
+
+	t.Setenv("TEST_DEFAULT_SEP", "1;2")
+	ints, err := ReadEnvIntSliceDefault("TEST_DEFAULT_SEP", nil)
+	if want := []int{1, 2}; err != nil || !reflect.DeepEqual(ints, want) {
+		t.Errorf("ReadEnvIntSliceDefault with %q = %v, %v; want %v, nil", ";", ints, err, want)
+	}
+
+	// The explicit separator still overrides per call.
+	got, err = ReadEnvSlice("TEST_DEFAULT_SEP", nil, ",")
+	if want := []string{"1;2"}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadEnvSlice with %q = %v, %v; want %v, nil", ",", got, err, want)
+	}
+}
+
+func TestReadEnvTypedSlice(t *testing.T) {
+	t.Run("Int", func(t *testing.T) {
+		t.Setenv("TEST_TYPED_SLICE", "1, 2 ,3")
+		got, err := ReadEnvTypedSlice[int]("TEST_TYPED_SLICE", nil, ",")
+		if want := []int{1, 2, 3}; err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadEnvTypedSlice[int] = %v, %v; want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("Float64", func(t *testing.T) {
+		t.Setenv("TEST_TYPED_SLICE", "0.5|1e3|-2")
+		got, err := ReadEnvTypedSlice[float64]("TEST_TYPED_SLICE", nil, "|")
+		if want := []float64{0.5, 1000, -2}; err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadEnvTypedSlice[float64] = %v, %v; want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("Bool", func(t *testing.T) {
+		t.Setenv("TEST_TYPED_SLICE", "true,false,1")
+		got, err := ReadEnvTypedSlice[bool]("TEST_TYPED_SLICE", nil, ",")
+		if want := []bool{true, false, true}; err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadEnvTypedSlice[bool] = %v, %v; want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("BadElement", func(t *testing.T) {
+		t.Setenv("TEST_TYPED_SLICE", "1,2,x")
+		def := []int{9}
+		got, err := ReadEnvTypedSlice("TEST_TYPED_SLICE", def, ",")
+		if !reflect.DeepEqual(got, def) || !errors.Is(err, strconv.ErrSyntax) {
+			t.Fatalf("ReadEnvTypedSlice[int] = %v, %v; want %v, strconv.ErrSyntax", got, err, def)
+		}
+		want := `failed to convert element 2 ("x") of "TEST_TYPED_SLICE": failed to convert "x" to int: strconv.ParseInt: parsing "x": invalid syntax`
+		if err.Error() != want {
+			t.Errorf("ReadEnvTypedSlice[int] error = %q; want %q", err, want)
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_TYPED_SLICE")
+		def := []bool{true}
+		if got, err := ReadEnvTypedSlice("TEST_TYPED_SLICE", def, ","); err != nil || !reflect.DeepEqual(got, def) {
+			t.Errorf("ReadEnvTypedSlice[bool] = %v, %v; want %v, nil", got, err, def)
+		}
+	})
+}
+
+func TestReadEnvFlexibleSlice(t *testing.T) {
+	def := []int{1}
+
+	t.Run("JSONAndDelimitedAgree", func(t *testing.T) {
+		want := []int{1, 2, 3}
+		for _, envValue := range []string{"[1,2,3]", " [1, 2, 3] ", "1,2,3", " 1 , 2 , 3 "} {
+			t.Setenv("TEST_FLEXIBLE_SLICE", envValue)
+			got, err := ReadEnvFlexibleSlice("TEST_FLEXIBLE_SLICE", def, ",")
+			if err != nil || !reflect.DeepEqual(got, want) {
+				t.Errorf("ReadEnvFlexibleSlice(%q) = %v, %v; want %v, nil", envValue, got, err, want)
+			}
+		}
+	})
+
+	t.Run("JSONStrings", func(t *testing.T) {
+		t.Setenv("TEST_FLEXIBLE_SLICE", `["a,b", "c"]`)
+		got, err := ReadEnvFlexibleSlice[string]("TEST_FLEXIBLE_SLICE", nil, ",")
+		if want := []string{"a,b", "c"}; err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadEnvFlexibleSlice[string] = %q, %v; want %q, nil", got, err, want)
+		}
+	})
+
+	t.Run("MalformedJSON", func(t *testing.T) {
+		t.Setenv("TEST_FLEXIBLE_SLICE", "[1,2")
+		got, err := ReadEnvFlexibleSlice("TEST_FLEXIBLE_SLICE", def, ",")
+		var syntaxErr *json.SyntaxError
+		if !reflect.DeepEqual(got, def) || !errors.As(err, &syntaxErr) {
+			t.Errorf("ReadEnvFlexibleSlice(%q) = %v, %v; want %v and a *json.SyntaxError", "[1,2", got, err, def)
+		}
+	})
+
+	t.Run("BadDelimitedElement", func(t *testing.T) {
+		t.Setenv("TEST_FLEXIBLE_SLICE", "1,two")
+		if got, err := ReadEnvFlexibleSlice("TEST_FLEXIBLE_SLICE", def, ","); !reflect.DeepEqual(got, def) || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvFlexibleSlice(%q) = %v, %v; want %v, strconv.ErrSyntax", "1,two", got, err, def)
+		}
+	})
+
+	t.Run("EmptyOrWhitespace", func(t *testing.T) {
+		for _, envValue := range []string{"", "   "} {
+			t.Setenv("TEST_FLEXIBLE_SLICE", envValue)
+			if got, err := ReadEnvFlexibleSlice("TEST_FLEXIBLE_SLICE", def, ","); err != nil || !reflect.DeepEqual(got, def) {
+				t.Errorf("ReadEnvFlexibleSlice(%q) = %v, %v; want %v, nil", envValue, got, err, def)
+			}
+		}
+	})
+}
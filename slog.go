@@ -0,0 +1,29 @@
+package envreader
+
+import (
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// ReadEnvSlogLevel reads key as a slog.Level. The value may be a level name
+// (debug, info, warn or error, case-insensitive, optionally with an offset
+// such as "info+2") or a raw integer such as "-4". An unknown value yields
+// defaultValue and an error. If the variable is unset or empty, defaultValue
+// is returned.
+func ReadEnvSlogLevel(key string, defaultValue slog.Level) (slog.Level, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	value := strings.TrimSpace(envValue)
+	if n, err := strconv.Atoi(value); err == nil {
+		return slog.Level(n), nil
+	}
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(value)); err != nil {
+		return defaultValue, &ConversionError{Key: key, Value: envValue, TargetType: "slog.Level", Err: err}
+	}
+	return level, nil
+}
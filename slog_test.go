@@ -0,0 +1,51 @@
+package envreader
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+)
+
+func TestReadEnvSlogLevel(t *testing.T) {
+	tests := []struct {
+		name        string
+		envValue    string
+		setEnv      bool
+		expectedVal slog.Level
+		expectErr   bool
+	}{
+		{name: "Debug", envValue: "debug", setEnv: true, expectedVal: slog.LevelDebug},
+		{name: "Info_Uppercase", envValue: "INFO", setEnv: true, expectedVal: slog.LevelInfo},
+		{name: "Warn_MixedCase", envValue: "Warn", setEnv: true, expectedVal: slog.LevelWarn},
+		{name: "Error", envValue: "error", setEnv: true, expectedVal: slog.LevelError},
+		{name: "NameWithOffset", envValue: "info+2", setEnv: true, expectedVal: slog.LevelInfo + 2},
+		{name: "Integer", envValue: "-4", setEnv: true, expectedVal: slog.LevelDebug},
+		{name: "Integer_Custom", envValue: " 12 ", setEnv: true, expectedVal: slog.Level(12)},
+		{name: "EnvNotExists", setEnv: false, expectedVal: slog.LevelWarn},
+		{name: "InvalidName", envValue: "verbose", setEnv: true, expectedVal: slog.LevelWarn, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_SLOG_LEVEL", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_SLOG_LEVEL")
+			}
+
+			actualVal, actualErr := ReadEnvSlogLevel("TEST_SLOG_LEVEL", slog.LevelWarn)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvSlogLevel(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			var convErr *ConversionError
+			if tt.expectErr {
+				if !errors.As(actualErr, &convErr) || convErr.Key != "TEST_SLOG_LEVEL" || convErr.TargetType != "slog.Level" {
+					t.Errorf("ReadEnvSlogLevel(%q) returned error %v; want a slog.Level ConversionError", tt.envValue, actualErr)
+				}
+			} else if actualErr != nil {
+				t.Errorf("ReadEnvSlogLevel(%q) returned unexpected error: %v", tt.envValue, actualErr)
+			}
+		})
+	}
+}
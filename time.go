@@ -0,0 +1,172 @@
+package envreader
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ReadEnvTime reads key as a timestamp in the given layout, using the same
+// layout syntax as time.Parse. If the variable is unset or empty, defaultValue
+// is returned.
+func ReadEnvTime(key string, defaultValue time.Time, layout string) (time.Time, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	val, err := time.Parse(layout, envValue)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to time.Time: %w", envValue, err)
+	}
+	return val, nil
+}
+
+// ReadEnvRFC3339 reads key as an RFC 3339 timestamp such as
+// "2024-01-02T15:04:05Z".
+func ReadEnvRFC3339(key string, defaultValue time.Time) (time.Time, error) {
+	return ReadEnvTime(key, defaultValue, time.RFC3339)
+}
+
+// ReadEnvUnix reads key as a Unix timestamp in seconds, such as "1704207845".
+// If the variable is unset or empty, defaultValue is returned.
+func ReadEnvUnix(key string, defaultValue time.Time) (time.Time, error) {
+	return readEpoch(key, defaultValue, func(n int64) time.Time { return time.Unix(n, 0) })
+}
+
+// ReadEnvUnixMilli is like ReadEnvUnix for timestamps in milliseconds.
+func ReadEnvUnixMilli(key string, defaultValue time.Time) (time.Time, error) {
+	return readEpoch(key, defaultValue, time.UnixMilli)
+}
+
+func readEpoch(key string, defaultValue time.Time, fromEpoch func(int64) time.Time) (time.Time, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	n, err := strconv.ParseInt(envValue, 10, 64)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to time.Time: %w", envValue, err)
+	}
+	return fromEpoch(n), nil
+}
+
+// ReadEnvDurationPositive reads key as a time.Duration like ReadEnv and
+// rejects zero and negative values, which make no sense for timeouts and
+// intervals. If the variable is unset or empty, defaultValue is returned.
+func ReadEnvDurationPositive(key string, defaultValue time.Duration) (time.Duration, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	val, err := convert(key, envValue, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+	if val <= 0 {
+		return defaultValue, fmt.Errorf("duration %q for %q must be positive", envValue, key)
+	}
+	return val, nil
+}
+
+// ReadEnvISODuration reads key as an ISO 8601 duration such as "PT1H30M" or
+// "P1DT2H". Weeks, days, hours, minutes and seconds are supported, and the
+// smallest component may have a fraction as in "PT0.5S"; a day is 24 hours.
+// Years and months are rejected because they have no fixed length. If the
+// variable is unset or empty, defaultValue is returned.
+func ReadEnvISODuration(key string, defaultValue time.Duration) (time.Duration, error) {
+	envValue, _ := GetRaw(key)
+	if envValue == "" {
+		return defaultValue, nil
+	}
+
+	val, err := parseISODuration(envValue)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to convert %q to ISO 8601 duration: %w", envValue, err)
+	}
+	return val, nil
+}
+
+// isoUnit is a designator allowed in one part of an ISO 8601 duration. A zero
+// size marks a designator that is recognized but unsupported.
+type isoUnit struct {
+	designator byte
+	name       string
+	size       time.Duration
+}
+
+var (
+	isoDateUnits = []isoUnit{{'Y', "years", 0}, {'M', "months", 0}, {'W', "weeks", 7 * 24 * time.Hour}, {'D', "days", 24 * time.Hour}}
+	isoTimeUnits = []isoUnit{{'H', "hours", time.Hour}, {'M', "minutes", time.Minute}, {'S', "seconds", time.Second}}
+)
+
+// parseISODuration parses the duration s of the form PnWnDTnHnMnS.
+func parseISODuration(s string) (time.Duration, error) {
+	rest, ok := strings.CutPrefix(s, "P")
+	if !ok || rest == "" {
+		return 0, strconv.ErrSyntax
+	}
+	datePart, timePart, hasTime := strings.Cut(rest, "T")
+	if hasTime && timePart == "" {
+		return 0, strconv.ErrSyntax
+	}
+
+	var total float64
+	for _, part := range []struct {
+		s     string
+		units []isoUnit
+		more  bool
+	}{{datePart, isoDateUnits, timePart != ""}, {timePart, isoTimeUnits, false}} {
+		n, err := sumISOComponents(part.s, part.units, part.more)
+		if err != nil {
+			return 0, err
+		}
+		total += n
+	}
+	if total >= math.MaxInt64 {
+		return 0, strconv.ErrRange
+	}
+	return time.Duration(total), nil
+}
+
+// sumISOComponents adds up the components of s, each a number followed by a
+// designator from units. Designators must appear in the order of units and at
+// most once, and only the last component may have a fraction. more reports
+// that further components follow s, in which case none of s may have one.
+func sumISOComponents(s string, units []isoUnit, more bool) (float64, error) {
+	var total float64
+	next := 0
+	for s != "" {
+		end := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if end <= 0 {
+			return 0, strconv.ErrSyntax
+		}
+		n, err := strconv.ParseFloat(s[:end], 64)
+		if err != nil {
+			return 0, strconv.ErrSyntax
+		}
+
+		i := next
+		for i < len(units) && units[i].designator != s[end] {
+			i++
+		}
+		if i == len(units) {
+			return 0, strconv.ErrSyntax
+		}
+		if units[i].size == 0 {
+			return 0, errors.New(units[i].name + " are not fixed-length and are not supported")
+		}
+		if strings.Contains(s[:end], ".") && (more || end+1 != len(s)) {
+			return 0, strconv.ErrSyntax
+		}
+		total += n * float64(units[i].size)
+		next = i + 1
+		s = s[end+1:]
+	}
+	return total, nil
+}
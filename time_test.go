@@ -0,0 +1,210 @@
+package envreader
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestReadEnvTime(t *testing.T) {
+	def := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("RFC3339_ValidValue", func(t *testing.T) {
+		t.Setenv("TEST_TIME", "2024-01-02T15:04:05Z")
+		got, err := ReadEnvRFC3339("TEST_TIME", def)
+		want := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ReadEnvRFC3339 = %v, %v; want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("CustomLayout", func(t *testing.T) {
+		t.Setenv("TEST_TIME", "02/01/2024")
+		got, err := ReadEnvTime("TEST_TIME", def, "02/01/2006")
+		want := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ReadEnvTime = %v, %v; want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("Unparseable_ReturnsDefault", func(t *testing.T) {
+		t.Setenv("TEST_TIME", "yesterday")
+		got, err := ReadEnvRFC3339("TEST_TIME", def)
+		var parseErr *time.ParseError
+		if !got.Equal(def) || !errors.As(err, &parseErr) {
+			t.Errorf("ReadEnvRFC3339 = %v, %v; want %v, *time.ParseError", got, err, def)
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_TIME")
+		got, err := ReadEnvRFC3339("TEST_TIME", def)
+		if err != nil || !got.Equal(def) {
+			t.Errorf("ReadEnvRFC3339 = %v, %v; want %v, nil", got, err, def)
+		}
+	})
+}
+
+func TestReadEnvUnix(t *testing.T) {
+	def := time.Unix(0, 0)
+
+	t.Run("Seconds", func(t *testing.T) {
+		t.Setenv("TEST_UNIX", "1704207845")
+		got, err := ReadEnvUnix("TEST_UNIX", def)
+		want := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ReadEnvUnix = %v, %v; want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("Milliseconds", func(t *testing.T) {
+		t.Setenv("TEST_UNIX", "1704207845123")
+		got, err := ReadEnvUnixMilli("TEST_UNIX", def)
+		want := time.Date(2024, 1, 2, 15, 4, 5, 123_000_000, time.UTC)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ReadEnvUnixMilli = %v, %v; want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("NonNumeric", func(t *testing.T) {
+		t.Setenv("TEST_UNIX", "2024-01-02")
+		got, err := ReadEnvUnix("TEST_UNIX", def)
+		if !got.Equal(def) || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ReadEnvUnix = %v, %v; want %v, strconv.ErrSyntax", got, err, def)
+		}
+	})
+
+	t.Run("EnvNotExists", func(t *testing.T) {
+		os.Unsetenv("TEST_UNIX")
+		if got, err := ReadEnvUnixMilli("TEST_UNIX", def); !got.Equal(def) || err != nil {
+			t.Errorf("ReadEnvUnixMilli = %v, %v; want %v, nil", got, err, def)
+		}
+	})
+}
+
+func TestReadEnvDurationPositive(t *testing.T) {
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       time.Duration
+		expectedErrString string
+	}{
+		{name: "Positive", envValue: "30s", setEnv: true, expectedVal: 30 * time.Second},
+		{name: "EnvNotExists", setEnv: false, expectedVal: time.Minute},
+		{
+			name:              "Negative",
+			envValue:          "-5m",
+			setEnv:            true,
+			expectedVal:       time.Minute,
+			expectedErrString: `duration "-5m" for "TEST_DURATION_POSITIVE" must be positive`,
+		},
+		{
+			name:              "Zero",
+			envValue:          "0s",
+			setEnv:            true,
+			expectedVal:       time.Minute,
+			expectedErrString: `duration "0s" for "TEST_DURATION_POSITIVE" must be positive`,
+		},
+		{
+			name:              "Invalid",
+			envValue:          "soon",
+			setEnv:            true,
+			expectedVal:       time.Minute,
+			expectedErrString: `failed to convert "soon" to time.Duration: time: invalid duration "soon"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_DURATION_POSITIVE", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_DURATION_POSITIVE")
+			}
+
+			actualVal, actualErr := ReadEnvDurationPositive("TEST_DURATION_POSITIVE", time.Minute)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvDurationPositive(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvDurationPositive(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+			} else if actualErr == nil || actualErr.Error() != tt.expectedErrString {
+				t.Errorf("ReadEnvDurationPositive(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
+
+func TestReadEnvISODuration(t *testing.T) {
+	tests := []struct {
+		name              string
+		envValue          string
+		setEnv            bool
+		expectedVal       time.Duration
+		expectedErr       error
+		expectedErrString string
+	}{
+		{name: "HoursMinutes", envValue: "PT1H30M", setEnv: true, expectedVal: 90 * time.Minute},
+		{name: "DaysHours", envValue: "P1DT2H", setEnv: true, expectedVal: 26 * time.Hour},
+		{name: "Weeks", envValue: "P2W", setEnv: true, expectedVal: 14 * 24 * time.Hour},
+		{name: "Seconds", envValue: "PT45S", setEnv: true, expectedVal: 45 * time.Second},
+		{name: "FractionalSeconds", envValue: "PT1M0.5S", setEnv: true, expectedVal: time.Minute + 500*time.Millisecond},
+		{name: "FractionalDays", envValue: "P1.5D", setEnv: true, expectedVal: 36 * time.Hour},
+		{name: "EnvNotExists", setEnv: false, expectedVal: time.Second},
+		{
+			name:              "Months_Unsupported",
+			envValue:          "P1M",
+			setEnv:            true,
+			expectedVal:       time.Second,
+			expectedErrString: `failed to convert "P1M" to ISO 8601 duration: months are not fixed-length and are not supported`,
+		},
+		{
+			name:              "Years_Unsupported",
+			envValue:          "P1Y2D",
+			setEnv:            true,
+			expectedVal:       time.Second,
+			expectedErrString: `failed to convert "P1Y2D" to ISO 8601 duration: years are not fixed-length and are not supported`,
+		},
+		{name: "Garbage", envValue: "soon", setEnv: true, expectedVal: time.Second, expectedErr: strconv.ErrSyntax},
+		{name: "GoSyntax", envValue: "1h30m", setEnv: true, expectedVal: time.Second, expectedErr: strconv.ErrSyntax},
+		{name: "DesignatorOnly", envValue: "P", setEnv: true, expectedVal: time.Second, expectedErr: strconv.ErrSyntax},
+		{name: "EmptyTimePart", envValue: "P1DT", setEnv: true, expectedVal: time.Second, expectedErr: strconv.ErrSyntax},
+		{name: "OutOfOrder", envValue: "PT30M1H", setEnv: true, expectedVal: time.Second, expectedErr: strconv.ErrSyntax},
+		{name: "HoursInDatePart", envValue: "P1H", setEnv: true, expectedVal: time.Second, expectedErr: strconv.ErrSyntax},
+		{name: "FractionNotLast", envValue: "PT1.5H30M", setEnv: true, expectedVal: time.Second, expectedErr: strconv.ErrSyntax},
+		{name: "FractionInDatePartBeforeTime", envValue: "P1.5DT2H", setEnv: true, expectedVal: time.Second, expectedErr: strconv.ErrSyntax},
+		{name: "Overflow", envValue: "P100000W", setEnv: true, expectedVal: time.Second, expectedErr: strconv.ErrRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv("TEST_ISO_DURATION", tt.envValue)
+			} else {
+				os.Unsetenv("TEST_ISO_DURATION")
+			}
+
+			actualVal, actualErr := ReadEnvISODuration("TEST_ISO_DURATION", time.Second)
+			if actualVal != tt.expectedVal {
+				t.Errorf("ReadEnvISODuration(%q) returned value %v; want %v", tt.envValue, actualVal, tt.expectedVal)
+			}
+			if tt.expectedErr == nil && tt.expectedErrString == "" {
+				if actualErr != nil {
+					t.Errorf("ReadEnvISODuration(%q) returned unexpected error: %v", tt.envValue, actualErr)
+				}
+				return
+			}
+			if tt.expectedErr != nil && !errors.Is(actualErr, tt.expectedErr) {
+				t.Errorf("ReadEnvISODuration(%q) returned error %v; want %v", tt.envValue, actualErr, tt.expectedErr)
+			}
+			if tt.expectedErrString != "" && (actualErr == nil || actualErr.Error() != tt.expectedErrString) {
+				t.Errorf("ReadEnvISODuration(%q) returned error %v; want %q", tt.envValue, actualErr, tt.expectedErrString)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package envreader
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	trackingEnabled atomic.Bool
+	trackedMu       sync.Mutex
+	trackedKeys     map[string]struct{}
+)
+
+// EnableTracking starts recording the name of every variable looked up
+// through GetRaw, which includes all readers in this package. The recorded
+// names are available from ReadKeys. Tracking is off by default and costs a
+// single atomic load per lookup while off.
+func EnableTracking() {
+	trackedMu.Lock()
+	if trackedKeys == nil {
+		trackedKeys = make(map[string]struct{})
+	}
+	trackedMu.Unlock()
+	trackingEnabled.Store(true)
+}
+
+// DisableTracking stops recording lookups and discards the keys recorded so
+// far.
+func DisableTracking() {
+	trackingEnabled.Store(false)
+	trackedMu.Lock()
+	trackedKeys = nil
+	trackedMu.Unlock()
+}
+
+// ReadKeys returns the sorted, deduplicated names of the variables looked up
+// since EnableTracking was called.
+func ReadKeys() []string {
+	trackedMu.Lock()
+	defer trackedMu.Unlock()
+
+	keys := make([]string, 0, len(trackedKeys))
+	for key := range trackedKeys {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// trackKey records key if tracking is enabled.
+func trackKey(key string) {
+	if !trackingEnabled.Load() {
+		return
+	}
+	trackedMu.Lock()
+	if trackedKeys != nil {
+		trackedKeys[key] = struct{}{}
+	}
+	trackedMu.Unlock()
+}
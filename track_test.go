@@ -0,0 +1,78 @@
+package envreader
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestTracking(t *testing.T) {
+	t.Cleanup(DisableTracking)
+
+	t.Run("DisabledRecordsNothing", func(t *testing.T) {
+		ReadEnv("TEST_TRACK_BEFORE", 0)
+		if keys := ReadKeys(); len(keys) != 0 {
+			t.Errorf("ReadKeys() = %v; want none while tracking is disabled", keys)
+		}
+	})
+
+	t.Run("RecordsSortedUniqueKeys", func(t *testing.T) {
+		EnableTracking()
+		t.Setenv("TEST_TRACK_B", "1")
+
+		ReadEnv("TEST_TRACK_B", 0)
+		ReadEnv("TEST_TRACK_A", "")
+		ReadEnv("TEST_TRACK_B", 0)
+		ReadEnvBool("TEST_TRACK_C", false)
+		ReadEnvAny(0, "TEST_TRACK_D", "TEST_TRACK_A")
+
+		want := []string{"TEST_TRACK_A", "TEST_TRACK_B", "TEST_TRACK_C", "TEST_TRACK_D"}
+		if keys := ReadKeys(); !reflect.DeepEqual(keys, want) {
+			t.Errorf("ReadKeys() = %v; want %v", keys, want)
+		}
+	})
+
+	t.Run("ConcurrentReads", func(t *testing.T) {
+		EnableTracking()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ReadEnv("TEST_TRACK_CONCURRENT", 0)
+				ReadKeys()
+			}()
+		}
+		wg.Wait()
+
+		found := false
+		for _, key := range ReadKeys() {
+			found = found || key == "TEST_TRACK_CONCURRENT"
+		}
+		if !found {
+			t.Errorf("ReadKeys() does not contain %q", "TEST_TRACK_CONCURRENT")
+		}
+	})
+
+	t.Run("RecordsFoldReads", func(t *testing.T) {
+		DisableTracking()
+		EnableTracking()
+		ReadEnvFold("TEST_TRACK_FOLD", 0)
+
+		want := []string{"TEST_TRACK_FOLD"}
+		if keys := ReadKeys(); !reflect.DeepEqual(keys, want) {
+			t.Errorf("ReadKeys() = %v; want %v", keys, want)
+		}
+	})
+
+	t.Run("DisableClears", func(t *testing.T) {
+		EnableTracking()
+		ReadEnv("TEST_TRACK_A", 0)
+		DisableTracking()
+		ReadEnv("TEST_TRACK_E", 0)
+		if keys := ReadKeys(); len(keys) != 0 {
+			t.Errorf("ReadKeys() = %v; want none after DisableTracking", keys)
+		}
+	})
+}
@@ -0,0 +1,239 @@
+package envreader
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// Unmarshal populates the exported fields of the struct pointed to by target
+// from the environment. Each field tagged env:"KEY" is read from KEY and
+// converted according to its type: strings, bools, signed and unsigned
+// integers, floats and time.Duration are supported. When KEY is unset or
+// empty, a default:"..." tag, if present, is converted instead; otherwise the
+// field is left unchanged. A tag of the form env:"KEY,required" instead makes
+// an unset or empty KEY an error wrapping ErrMissingRequired, even if a
+// default is given. A field that fails to bind keeps its previous value and
+// does not stop the remaining fields from being bound; the errors for all such
+// fields are returned joined.
+func Unmarshal(target any) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("envreader: Unmarshal target must be a non-nil pointer to a struct, got %T", target)
+	}
+	return bindStruct(rv.Elem())
+}
+
+// Validate reports the errors Unmarshal would return for target without
+// modifying it, for a dry run such as a --check-config command. The fields are
+// bound into a copy of the struct, so missing required variables, invalid
+// values and bad defaults are all detected.
+func Validate(target any) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("envreader: Validate target must be a non-nil pointer to a struct, got %T", target)
+	}
+	scratch := reflect.New(rv.Elem().Type()).Elem()
+	scratch.Set(rv.Elem())
+	return bindStruct(scratch)
+}
+
+// bindStruct binds every tagged exported field of rv, a struct value, and
+// joins the errors.
+func bindStruct(rv reflect.Value) error {
+	var errs []error
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag, ok := field.Tag.Lookup("env")
+		if !ok || !field.IsExported() {
+			continue
+		}
+		if err := bindField(rv.Field(i), field, tag); err != nil {
+			errs = append(errs, fmt.Errorf("field %s: %w", field.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// UnmarshalStrict is like Unmarshal but also fails if the environment holds
+// variables under prefix that no field's env tag refers to, which catches
+// misspelled keys such as APP_PROT for APP_PORT. prefix follows the naming of
+// Snapshot. The error lists the unknown variables and is joined with any
+// binding errors. prefix must not be empty, since every variable in the
+// process environment would then count as unknown; target is left untouched
+// in that case.
+func UnmarshalStrict(target any, prefix string) error {
+	if prefix == "" {
+		return errors.New("envreader: UnmarshalStrict prefix must not be empty")
+	}
+	bindErr := Unmarshal(target)
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return bindErr
+	}
+
+	consumed := make(map[string]bool)
+	rt := rv.Elem().Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if tag, ok := field.Tag.Lookup("env"); ok && field.IsExported() {
+			key, _ := parseEnvTag(tag)
+			consumed[key] = true
+		}
+	}
+
+	var unknown []string
+	for name := range Snapshot(prefix) {
+		if key := keyPrefix(prefix) + name; !consumed[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) == 0 {
+		return bindErr
+	}
+	slices.Sort(unknown)
+	return errors.Join(bindErr, fmt.Errorf("envreader: unknown variables with prefix %q: %s", prefix, strings.Join(unknown, ", ")))
+}
+
+// bindField sets v, the value of field, from the environment variable named
+// by tag or from the field's default tag.
+func bindField(v reflect.Value, field reflect.StructField, tag string) error {
+	if !isSupportedType(field.Type) {
+		return fmt.Errorf("unsupported type %s", field.Type)
+	}
+
+	key, required := parseEnvTag(tag)
+	envValue, ok := GetRaw(key)
+	if required && envValue == "" {
+		return fmt.Errorf("%w: %q", ErrMissingRequired, key)
+	}
+	if ok && (envValue != "" || field.Type.Kind() == reflect.String) {
+		return setValue(v, key, envValue)
+	}
+	if def, ok := field.Tag.Lookup("default"); ok {
+		if err := setDefault(v, key, def); err != nil {
+			return fmt.Errorf("invalid default: %w", err)
+		}
+	}
+	return nil
+}
+
+// ParseDefault converts raw, a default given as a string such as the value of
+// a default:"..." tag, to T with the same rules ReadEnv applies to a
+// variable's value. There is no fallback: a raw that does not convert, such as
+// "abc" for an int, is reported as a *ConversionError.
+func ParseDefault[T any](raw string) (T, error) {
+	return parseValue[T](raw)
+}
+
+// defaultParsers holds ParseDefault for the underlying type of each kind
+// supported by Unmarshal.
+var defaultParsers = map[reflect.Kind]func(string) (any, error){
+	reflect.String:  func(s string) (any, error) { return ParseDefault[string](s) },
+	reflect.Bool:    func(s string) (any, error) { return ParseDefault[bool](s) },
+	reflect.Int:     func(s string) (any, error) { return ParseDefault[int](s) },
+	reflect.Int8:    func(s string) (any, error) { return ParseDefault[int8](s) },
+	reflect.Int16:   func(s string) (any, error) { return ParseDefault[int16](s) },
+	reflect.Int32:   func(s string) (any, error) { return ParseDefault[int32](s) },
+	reflect.Int64:   func(s string) (any, error) { return ParseDefault[int64](s) },
+	reflect.Uint:    func(s string) (any, error) { return ParseDefault[uint](s) },
+	reflect.Uint8:   func(s string) (any, error) { return ParseDefault[uint8](s) },
+	reflect.Uint16:  func(s string) (any, error) { return ParseDefault[uint16](s) },
+	reflect.Uint32:  func(s string) (any, error) { return ParseDefault[uint32](s) },
+	reflect.Uint64:  func(s string) (any, error) { return ParseDefault[uint64](s) },
+	reflect.Float32: func(s string) (any, error) { return ParseDefault[float32](s) },
+	reflect.Float64: func(s string) (any, error) { return ParseDefault[float64](s) },
+}
+
+// setDefault converts raw, the default for key, to the type of v with
+// ParseDefault and stores it.
+func setDefault(v reflect.Value, key, raw string) error {
+	parse := defaultParsers[v.Kind()]
+	if v.Type() == durationType {
+		parse = func(s string) (any, error) { return ParseDefault[time.Duration](s) }
+	}
+	if parse == nil {
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	val, err := parse(raw)
+	if err != nil {
+		var convErr *ConversionError
+		if errors.As(err, &convErr) {
+			convErr.Key = key
+		}
+		return err
+	}
+	v.Set(reflect.ValueOf(val).Convert(v.Type()))
+	return nil
+}
+
+// parseEnvTag splits an env struct tag into the variable name and its
+// options.
+func parseEnvTag(tag string) (key string, required bool) {
+	key, opts, _ := strings.Cut(tag, ",")
+	for _, opt := range strings.Split(opts, ",") {
+		if strings.TrimSpace(opt) == "required" {
+			required = true
+		}
+	}
+	return strings.TrimSpace(key), required
+}
+
+func isSupportedType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// setValue converts raw, the value of key, to the type of v and stores it.
+func setValue(v reflect.Value, key, raw string) error {
+	var err error
+	switch {
+	case v.Type() == durationType:
+		var d time.Duration
+		if d, err = time.ParseDuration(raw); err == nil {
+			v.SetInt(int64(d))
+		}
+	case v.Kind() == reflect.String:
+		v.SetString(raw)
+	case v.Kind() == reflect.Bool:
+		var b bool
+		if b, err = strconv.ParseBool(raw); err == nil {
+			v.SetBool(b)
+		}
+	case v.CanInt():
+		var n int64
+		if n, err = strconv.ParseInt(raw, 10, v.Type().Bits()); err == nil {
+			v.SetInt(n)
+		}
+	case v.CanUint():
+		var n uint64
+		if n, err = strconv.ParseUint(raw, 10, v.Type().Bits()); err == nil {
+			v.SetUint(n)
+		}
+	case v.CanFloat():
+		var f float64
+		if f, err = strconv.ParseFloat(raw, v.Type().Bits()); err == nil {
+			v.SetFloat(f)
+		}
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	if err != nil {
+		return &ConversionError{Key: key, Value: raw, TargetType: v.Type().String(), Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,384 @@
+package envreader
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUnmarshal(t *testing.T) {
+	type config struct {
+		Host     string        `env:"TEST_BIND_HOST"`
+		Port     uint16        `env:"TEST_BIND_PORT"`
+		Debug    bool          `env:"TEST_BIND_DEBUG"`
+		Ratio    float64       `env:"TEST_BIND_RATIO"`
+		Timeout  time.Duration `env:"TEST_BIND_TIMEOUT"`
+		Workers  int           `env:"TEST_BIND_WORKERS" default:"4"`
+		Missing  string        `env:"TEST_BIND_MISSING"`
+		Untagged string
+		private  string `env:"TEST_BIND_PRIVATE"`
+	}
+
+	t.Run("MixedTypes", func(t *testing.T) {
+		t.Setenv("TEST_BIND_HOST", "localhost")
+		t.Setenv("TEST_BIND_PORT", "8080")
+		t.Setenv("TEST_BIND_DEBUG", "true")
+		t.Setenv("TEST_BIND_RATIO", "0.25")
+		t.Setenv("TEST_BIND_TIMEOUT", "3s")
+		t.Setenv("TEST_BIND_PRIVATE", "secret")
+		os.Unsetenv("TEST_BIND_WORKERS")
+		os.Unsetenv("TEST_BIND_MISSING")
+
+		cfg := config{Missing: "keep", Untagged: "keep"}
+		if err := Unmarshal(&cfg); err != nil {
+			t.Fatalf("Unmarshal returned unexpected error: %v", err)
+		}
+
+		want := config{
+			Host:     "localhost",
+			Port:     8080,
+			Debug:    true,
+			Ratio:    0.25,
+			Timeout:  3 * time.Second,
+			Workers:  4, // From the default tag
+			Missing:  "keep",
+			Untagged: "keep",
+		}
+		if cfg != want {
+			t.Errorf("Unmarshal result = %+v; want %+v", cfg, want)
+		}
+	})
+
+	t.Run("EnvOverridesDefault", func(t *testing.T) {
+		t.Setenv("TEST_BIND_WORKERS", "16")
+
+		var cfg config
+		if err := Unmarshal(&cfg); err != nil {
+			t.Fatalf("Unmarshal returned unexpected error: %v", err)
+		}
+		if cfg.Workers != 16 {
+			t.Errorf("Workers = %d; want 16", cfg.Workers)
+		}
+	})
+
+	t.Run("InvalidValue", func(t *testing.T) {
+		t.Setenv("TEST_BIND_PORT", "70000")
+
+		var cfg config
+		err := Unmarshal(&cfg)
+		if !errors.Is(err, strconv.ErrRange) {
+			t.Fatalf("Unmarshal error = %v; want strconv.ErrRange", err)
+		}
+		want := `field Port: failed to convert "70000" to uint16: strconv.ParseUint: parsing "70000": value out of range`
+		if err.Error() != want {
+			t.Errorf("Unmarshal error = %q; want %q", err, want)
+		}
+	})
+
+	t.Run("MultipleInvalid_ContinuesBinding", func(t *testing.T) {
+		var cfg struct {
+			Host    string        `env:"TEST_BIND_HOST"`
+			Port    uint16        `env:"TEST_BIND_PORT"`
+			Debug   bool          `env:"TEST_BIND_DEBUG"`
+			Timeout time.Duration `env:"TEST_BIND_TIMEOUT"`
+		}
+		cfg.Port = 80
+		cfg.Timeout = time.Second
+		t.Setenv("TEST_BIND_HOST", "localhost")
+		t.Setenv("TEST_BIND_PORT", "http")
+		t.Setenv("TEST_BIND_DEBUG", "true")
+		t.Setenv("TEST_BIND_TIMEOUT", "soon")
+
+		err := Unmarshal(&cfg)
+		if err == nil {
+			t.Fatal("Unmarshal returned nil error; want errors for Port and Timeout")
+		}
+		for _, field := range []string{"field Port", "field Timeout"} {
+			if !strings.Contains(err.Error(), field) {
+				t.Errorf("Unmarshal error = %q; want it to mention %q", err, field)
+			}
+		}
+		if cfg.Host != "localhost" || !cfg.Debug {
+			t.Errorf("valid fields = %q, %v; want %q, true", cfg.Host, cfg.Debug, "localhost")
+		}
+		if cfg.Port != 80 || cfg.Timeout != time.Second {
+			t.Errorf("invalid fields = %d, %v; want prior values 80, 1s", cfg.Port, cfg.Timeout)
+		}
+	})
+
+	t.Run("UnsupportedFieldKind", func(t *testing.T) {
+		var cfg struct {
+			Hosts []string `env:"TEST_BIND_HOSTS"`
+		}
+		err := Unmarshal(&cfg)
+		if err == nil || !strings.Contains(err.Error(), "field Hosts") {
+			t.Errorf("Unmarshal error = %v; want an error naming field Hosts", err)
+		}
+	})
+
+	t.Run("NonPointerTarget", func(t *testing.T) {
+		if err := Unmarshal(config{}); err == nil {
+			t.Error("Unmarshal(config{}) returned nil error; want an error")
+		}
+	})
+}
+
+func TestUnmarshal_Required(t *testing.T) {
+	type config struct {
+		Password string `env:"TEST_BIND_PASSWORD,required"`
+		Region   string `env:"TEST_BIND_REGION,required" default:"eu-west-1"`
+		Optional int    `env:"TEST_BIND_OPTIONAL" default:"7"`
+	}
+
+	t.Run("Present", func(t *testing.T) {
+		t.Setenv("TEST_BIND_PASSWORD", "hunter2")
+		t.Setenv("TEST_BIND_REGION", "us-east-1")
+		os.Unsetenv("TEST_BIND_OPTIONAL")
+
+		var cfg config
+		if err := Unmarshal(&cfg); err != nil {
+			t.Fatalf("Unmarshal returned unexpected error: %v", err)
+		}
+		want := config{Password: "hunter2", Region: "us-east-1", Optional: 7}
+		if cfg != want {
+			t.Errorf("Unmarshal result = %+v; want %+v", cfg, want)
+		}
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		os.Unsetenv("TEST_BIND_PASSWORD")
+		t.Setenv("TEST_BIND_REGION", "us-east-1")
+
+		var cfg config
+		err := Unmarshal(&cfg)
+		if !errors.Is(err, ErrMissingRequired) {
+			t.Fatalf("Unmarshal error = %v; want ErrMissingRequired", err)
+		}
+		want := `field Password: required environment variable is not set: "TEST_BIND_PASSWORD"`
+		if err.Error() != want {
+			t.Errorf("Unmarshal error = %q; want %q", err, want)
+		}
+		if cfg.Region != "us-east-1" || cfg.Optional != 7 {
+			t.Errorf("Unmarshal result = %+v; want other fields bound", cfg)
+		}
+	})
+
+	t.Run("EmptyCountsAsMissing", func(t *testing.T) {
+		t.Setenv("TEST_BIND_PASSWORD", "")
+		t.Setenv("TEST_BIND_REGION", "us-east-1")
+
+		var cfg config
+		if err := Unmarshal(&cfg); !errors.Is(err, ErrMissingRequired) {
+			t.Errorf("Unmarshal error = %v; want ErrMissingRequired", err)
+		}
+	})
+
+	t.Run("RequiredWinsOverDefault", func(t *testing.T) {
+		t.Setenv("TEST_BIND_PASSWORD", "hunter2")
+		os.Unsetenv("TEST_BIND_REGION")
+
+		var cfg config
+		err := Unmarshal(&cfg)
+		if !errors.Is(err, ErrMissingRequired) || !strings.Contains(err.Error(), "field Region") {
+			t.Errorf("Unmarshal error = %v; want ErrMissingRequired naming field Region", err)
+		}
+		if cfg.Region != "" {
+			t.Errorf("Region = %q; want it left empty", cfg.Region)
+		}
+	})
+}
+
+func TestUnmarshalStrict(t *testing.T) {
+	type config struct {
+		Host string `env:"TEST_STRICT_HOST"`
+		Port int    `env:"TEST_STRICT_PORT"`
+	}
+
+	t.Run("AllConsumed", func(t *testing.T) {
+		t.Setenv("TEST_STRICT_HOST", "localhost")
+		t.Setenv("TEST_STRICT_PORT", "8080")
+
+		var cfg config
+		if err := UnmarshalStrict(&cfg, "TEST_STRICT"); err != nil {
+			t.Fatalf("UnmarshalStrict returned unexpected error: %v", err)
+		}
+		if cfg.Host != "localhost" || cfg.Port != 8080 {
+			t.Errorf("UnmarshalStrict result = %+v; want localhost:8080", cfg)
+		}
+	})
+
+	t.Run("UnknownVariable", func(t *testing.T) {
+		t.Setenv("TEST_STRICT_HOST", "localhost")
+		t.Setenv("TEST_STRICT_PORT", "8080")
+		t.Setenv("TEST_STRICT_PROT", "9090")
+		t.Setenv("TEST_STRICTER_FLAG", "1") // Different prefix, not reported
+
+		var cfg config
+		err := UnmarshalStrict(&cfg, "TEST_STRICT")
+		want := `envreader: unknown variables with prefix "TEST_STRICT": TEST_STRICT_PROT`
+		if err == nil || err.Error() != want {
+			t.Errorf("UnmarshalStrict error = %v; want %q", err, want)
+		}
+		if cfg.Host != "localhost" || cfg.Port != 8080 {
+			t.Errorf("UnmarshalStrict result = %+v; want fields still bound", cfg)
+		}
+	})
+
+	t.Run("JoinedWithBindErrors", func(t *testing.T) {
+		t.Setenv("TEST_STRICT_PORT", "http")
+		t.Setenv("TEST_STRICT_PROT", "9090")
+
+		var cfg config
+		err := UnmarshalStrict(&cfg, "TEST_STRICT_")
+		if !errors.Is(err, strconv.ErrSyntax) || !strings.Contains(err.Error(), "TEST_STRICT_PROT") {
+			t.Errorf("UnmarshalStrict error = %v; want both the Port error and TEST_STRICT_PROT", err)
+		}
+	})
+
+	t.Run("EmptyPrefix", func(t *testing.T) {
+		t.Setenv("TEST_STRICT_HOST", "localhost")
+
+		var cfg config
+		err := UnmarshalStrict(&cfg, "")
+		want := "envreader: UnmarshalStrict prefix must not be empty"
+		if err == nil || err.Error() != want {
+			t.Errorf("UnmarshalStrict error = %v; want %q", err, want)
+		}
+		if cfg != (config{}) {
+			t.Errorf("UnmarshalStrict result = %+v; want target untouched", cfg)
+		}
+	})
+
+	t.Run("NonPointerTarget", func(t *testing.T) {
+		if err := UnmarshalStrict(config{}, "TEST_STRICT"); err == nil {
+			t.Error("UnmarshalStrict(config{}) returned nil error; want an error")
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+	type config struct {
+		Host    string        `env:"TEST_VALIDATE_HOST,required"`
+		Port    uint16        `env:"TEST_VALIDATE_PORT" default:"8080"`
+		Timeout time.Duration `env:"TEST_VALIDATE_TIMEOUT"`
+	}
+
+	t.Run("GoodEnvironment", func(t *testing.T) {
+		t.Setenv("TEST_VALIDATE_HOST", "localhost")
+		t.Setenv("TEST_VALIDATE_TIMEOUT", "5s")
+		os.Unsetenv("TEST_VALIDATE_PORT")
+
+		cfg := config{Host: "keep"}
+		if err := Validate(&cfg); err != nil {
+			t.Errorf("Validate returned unexpected error: %v", err)
+		}
+		if cfg != (config{Host: "keep"}) {
+			t.Errorf("Validate modified target to %+v", cfg)
+		}
+		if err := Unmarshal(&cfg); err != nil {
+			t.Errorf("Unmarshal returned error %v after Validate succeeded", err)
+		}
+	})
+
+	t.Run("BadEnvironment", func(t *testing.T) {
+		os.Unsetenv("TEST_VALIDATE_HOST")
+		t.Setenv("TEST_VALIDATE_PORT", "70000")
+		t.Setenv("TEST_VALIDATE_TIMEOUT", "soon")
+
+		var cfg config
+		validateErr := Validate(&cfg)
+		if !errors.Is(validateErr, ErrMissingRequired) || !errors.Is(validateErr, strconv.ErrRange) {
+			t.Errorf("Validate error = %v; want ErrMissingRequired and strconv.ErrRange", validateErr)
+		}
+		if cfg != (config{}) {
+			t.Errorf("Validate modified target to %+v", cfg)
+		}
+
+		unmarshalErr := Unmarshal(&config{})
+		if validateErr == nil || unmarshalErr == nil || validateErr.Error() != unmarshalErr.Error() {
+			t.Errorf("Validate error = %v; want the same as Unmarshal error %v", validateErr, unmarshalErr)
+		}
+	})
+
+	t.Run("NonPointerTarget", func(t *testing.T) {
+		if err := Validate(config{}); err == nil {
+			t.Error("Validate(config{}) returned nil error; want an error")
+		}
+	})
+}
+
+func TestParseDefault(t *testing.T) {
+	t.Run("Int", func(t *testing.T) {
+		if got, err := ParseDefault[int]("8080"); got != 8080 || err != nil {
+			t.Errorf("ParseDefault[int](%q) = %v, %v; want 8080, nil", "8080", got, err)
+		}
+	})
+
+	t.Run("Bool", func(t *testing.T) {
+		if got, err := ParseDefault[bool]("true"); !got || err != nil {
+			t.Errorf("ParseDefault[bool](%q) = %v, %v; want true, nil", "true", got, err)
+		}
+	})
+
+	t.Run("Float64", func(t *testing.T) {
+		if got, err := ParseDefault[float64]("0.75"); got != 0.75 || err != nil {
+			t.Errorf("ParseDefault[float64](%q) = %v, %v; want 0.75, nil", "0.75", got, err)
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if got, err := ParseDefault[string]("eu-west-1"); got != "eu-west-1" || err != nil {
+			t.Errorf("ParseDefault[string](%q) = %q, %v; want %q, nil", "eu-west-1", got, err, "eu-west-1")
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		got, err := ParseDefault[int]("abc")
+		var convErr *ConversionError
+		if got != 0 || !errors.As(err, &convErr) || !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ParseDefault[int](%q) = %v, %v; want 0 and a *ConversionError", "abc", got, err)
+		}
+	})
+}
+
+func TestUnmarshal_Defaults(t *testing.T) {
+	type config struct {
+		Port    port          `env:"TEST_DEFAULT_PORT" default:"8080"`
+		Debug   bool          `env:"TEST_DEFAULT_DEBUG" default:"true"`
+		Ratio   float64       `env:"TEST_DEFAULT_RATIO" default:"0.5"`
+		Region  string        `env:"TEST_DEFAULT_REGION" default:"eu-west-1"`
+		Timeout time.Duration `env:"TEST_DEFAULT_TIMEOUT" default:"2s"`
+	}
+	for _, key := range []string{"TEST_DEFAULT_PORT", "TEST_DEFAULT_DEBUG", "TEST_DEFAULT_RATIO", "TEST_DEFAULT_REGION", "TEST_DEFAULT_TIMEOUT", "TEST_DEFAULT_WORKERS"} {
+		unsetForTest(t, key)
+	}
+
+	t.Run("Applied", func(t *testing.T) {
+		var cfg config
+		if err := Unmarshal(&cfg); err != nil {
+			t.Fatalf("Unmarshal returned unexpected error: %v", err)
+		}
+		want := config{Port: 8080, Debug: true, Ratio: 0.5, Region: "eu-west-1", Timeout: 2 * time.Second}
+		if cfg != want {
+			t.Errorf("Unmarshal result = %+v; want %+v", cfg, want)
+		}
+	})
+
+	t.Run("InvalidDefault", func(t *testing.T) {
+		var cfg struct {
+			Workers int `env:"TEST_DEFAULT_WORKERS" default:"abc"`
+		}
+		err := Unmarshal(&cfg)
+		want := `field Workers: invalid default: failed to convert "abc" to int: strconv.ParseInt: parsing "abc": invalid syntax`
+		if err == nil || err.Error() != want {
+			t.Fatalf("Unmarshal error = %v; want %q", err, want)
+		}
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) || convErr.Key != "TEST_DEFAULT_WORKERS" {
+			t.Errorf("Unmarshal error = %#v; want *ConversionError for %q", err, "TEST_DEFAULT_WORKERS")
+		}
+	})
+}